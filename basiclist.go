@@ -0,0 +1,245 @@
+package ipfix
+
+import (
+	"net"
+	"net/netip"
+	"reflect"
+	"time"
+)
+
+// serializeListValuesTo writes the values of a basicList with the list element subie and returns the
+// number of written bytes. Common slice types are written without reflection, which would allocate an
+// interface for every value; everything else falls back to reflection.
+func serializeListValuesTo(buffer scratchBuffer, subie InformationElement, value interface{}) (written int, err error) {
+	t := subie.Type
+	length := int(subie.Length)
+	var n int
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case []interface{}:
+		for _, x := range v {
+			if n, err = t.serializeDataTo(buffer, x, length); err != nil {
+				return
+			}
+			written += n
+		}
+		return
+	}
+	switch t {
+	case Unsigned8Type, Unsigned16Type, Unsigned32Type, Unsigned64Type, Signed8Type, Signed16Type, Signed32Type, Signed64Type, BooleanType:
+		if buffer.strict() {
+			// strict conversion needs the signedness of the values
+			break
+		}
+		switch v := value.(type) {
+		case []uint64:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, x, false, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []uint32:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, uint64(x), false, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []uint16:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, uint64(x), false, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []uint8:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, uint64(x), false, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []uint:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, uint64(x), false, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []int64:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, uint64(x), true, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []int32:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, uint64(x), true, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []int16:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, uint64(x), true, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []int8:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, uint64(x), true, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []int:
+			for _, x := range v {
+				if n, err = serializeUnsignedTo(buffer, t, uint64(x), true, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		}
+	case Float32Type:
+		switch v := value.(type) {
+		case []float32:
+			for _, x := range v {
+				if n, err = serializeFloat32To(buffer, x); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []float64:
+			for _, x := range v {
+				if n, err = serializeFloat32To(buffer, float32(x)); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		}
+	case Float64Type:
+		switch v := value.(type) {
+		case []float32:
+			for _, x := range v {
+				if n, err = serializeFloat64To(buffer, t, float64(x), length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []float64:
+			for _, x := range v {
+				if n, err = serializeFloat64To(buffer, t, x, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		}
+	case OctetArrayType, Ipv4AddressType, Ipv6AddressType, MacAddressType, StringType:
+		if length == 0 {
+			length = int(DefaultSize[t])
+		}
+		switch v := value.(type) {
+		case []string:
+			for _, x := range v {
+				if !octetsFit(t, []byte(x), length) {
+					return written, ConversionError{t, x}
+				}
+				if n, err = serializeOctetsTo(buffer, []byte(x), length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case [][]byte:
+			for _, x := range v {
+				if !octetsFit(t, x, length) {
+					return written, ConversionError{t, x}
+				}
+				if n, err = serializeOctetsTo(buffer, x, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []net.IP:
+			for _, x := range v {
+				if !octetsFit(t, x, length) {
+					return written, ConversionError{t, x}
+				}
+				if n, err = serializeOctetsTo(buffer, x, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []netip.Addr:
+			for _, x := range v {
+				if n, err = serializeAddrTo(buffer, t, x, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		case []net.HardwareAddr:
+			for _, x := range v {
+				if !octetsFit(t, x, length) {
+					return written, ConversionError{t, x}
+				}
+				if n, err = serializeOctetsTo(buffer, x, length); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		}
+	case DateTimeSecondsType, DateTimeMillisecondsType, DateTimeMicrosecondsType, DateTimeNanosecondsType:
+		if v, ok := value.([]time.Time); ok {
+			for _, x := range v {
+				if n, err = serializeTimeTo(buffer, t, uint64(x.Unix()), uint64(x.Nanosecond())); err != nil {
+					return
+				}
+				written += n
+			}
+			return
+		}
+	}
+	return serializeReflectedListValuesTo(buffer, subie, value)
+}
+
+// serializeReflectedListValuesTo writes the values of any slice or array with reflection.
+func serializeReflectedListValuesTo(buffer scratchBuffer, subie InformationElement, value interface{}) (written int, err error) {
+	values := reflect.ValueOf(value)
+	for values.Kind() == reflect.Ptr {
+		values = values.Elem()
+	}
+	if values.Kind() != reflect.Slice && values.Kind() != reflect.Array {
+		return 0, ConversionError{BasicListType, value}
+	}
+	l := values.Len()
+	for i := 0; i < l; i++ {
+		n, err := subie.Type.serializeDataTo(buffer, values.Index(i).Interface(), int(subie.Length))
+		if err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
@@ -0,0 +1,160 @@
+package ipfix_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+const listSize = 1000
+
+type listCase struct {
+	name   string
+	ie     string
+	values interface{}
+	// pointer to values, which is not covered by the fast paths
+	reflected interface{}
+}
+
+func listCases() []listCase {
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	u64 := make([]uint64, listSize)
+	u32 := make([]uint32, listSize)
+	u16 := make([]uint16, listSize)
+	u8 := make([]uint8, listSize)
+	i64 := make([]int64, listSize)
+	i32 := make([]int32, listSize)
+	i16 := make([]int16, listSize)
+	i8 := make([]int8, listSize)
+	i := make([]int, listSize)
+	f64 := make([]float64, listSize)
+	s := make([]string, listSize)
+	ip := make([]net.IP, listSize)
+	ts := make([]time.Time, listSize)
+	for n := 0; n < listSize; n++ {
+		u64[n] = uint64(n) * 1500
+		u32[n] = uint32(n) * 1500
+		u16[n] = uint16(n)
+		u8[n] = uint8(n)
+		i64[n] = int64(n) * 1500
+		i32[n] = int32(n) * 1500
+		i16[n] = int16(n)
+		i8[n] = int8(n % 128)
+		i[n] = n * 1500
+		f64[n] = float64(n) / 3
+		s[n] = fmt.Sprintf("name%d", n)
+		ip[n] = net.IP{10, 0, byte(n >> 8), byte(n)}
+		ts[n] = now.Add(time.Duration(n) * time.Microsecond)
+	}
+	return []listCase{
+		{"uint64", "octetDeltaCount", u64, &u64},
+		{"uint32", "octetDeltaCount", u32, &u32},
+		{"uint16", "sourceTransportPort", u16, &u16},
+		{"uint8", "protocolIdentifier", u8, &u8},
+		{"int64", "octetDeltaCount", i64, &i64},
+		{"int32", "octetDeltaCount", i32, &i32},
+		{"int16", "sourceTransportPort", i16, &i16},
+		{"int8", "protocolIdentifier", i8, &i8},
+		{"int", "octetDeltaCount", i, &i},
+		{"float64", "samplingProbability", f64, &f64},
+		{"string", "applicationName", s, &s},
+		{"net.IP", "sourceIPv4Address", ip, &ip},
+		{"time.Time", "flowStartMicroseconds", ts, &ts},
+	}
+}
+
+func encodeList(t testing.TB, ie ipfix.InformationElement, value interface{}) []byte {
+	buf := new(bytes.Buffer)
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := msgStream.AddTemplate(now, ipfix.NewBasicList("list", ie, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msgStream.SendData(now, id, value); err != nil {
+		t.Fatal(err)
+	}
+	if err := msgStream.Flush(now); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestBasicListFastPaths(t *testing.T) {
+	ipfix.LoadIANASpec()
+	for _, c := range listCases() {
+		ie, err := ipfix.GetInformationElement(c.ie)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fast := encodeList(t, ie, c.values)
+		reflected := encodeList(t, ie, c.reflected)
+		if !bytes.Equal(fast, reflected) {
+			t.Errorf("%s: encoding differs from reflection:\n% x\n% x", c.name, fast, reflected)
+		}
+	}
+}
+
+func BenchmarkBasicList(b *testing.B) {
+	ipfix.LoadIANASpec()
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	for _, c := range listCases() {
+		ie, err := ipfix.GetInformationElement(c.ie)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, variant := range []struct {
+			name  string
+			value interface{}
+		}{{"fast", c.values}, {"reflect", c.reflected}} {
+			b.Run(c.name+"/"+variant.name, func(b *testing.B) {
+				msgStream, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+				if err != nil {
+					b.Fatal(err)
+				}
+				id, err := msgStream.AddTemplate(now, ipfix.NewBasicList("list", ie, 0))
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if err := msgStream.SendData(now, id, variant.value); err != nil {
+						b.Fatal(err)
+					}
+					msgStream.Flush(now)
+				}
+			})
+		}
+	}
+}
+
+func TestBasicListAddressLength(t *testing.T) {
+	ipfix.LoadIANASpec()
+	ie, err := ipfix.GetInformationElement("sourceIPv4Address")
+	if err != nil {
+		t.Fatal(err)
+	}
+	long := "\x0a\x00\x00\x01\x02"
+	for _, value := range []interface{}{[]string{long}, []interface{}{long}, [][]byte{[]byte(long)}} {
+		msgStream, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id, err := msgStream.AddTemplate(0, ipfix.NewBasicList("list", ie, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := msgStream.SendData(0, id, value).(ipfix.ConversionError); !ok {
+			t.Errorf("%T: expected ConversionError for oversized address", value)
+		}
+	}
+}
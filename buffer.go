@@ -9,6 +9,8 @@ type scratchBuffer interface {
 	bytesFree() int
 	finalize(io.Writer) (err error)
 	length() int
+	// strict returns true if values must be converted without loss, see MessageStream.Strict
+	strict() bool
 }
 
 type basicBuffer []byte
@@ -43,6 +45,10 @@ func (b *basicBuffer) length() int {
 	return len(*b)
 }
 
+func (b *basicBuffer) strict() bool {
+	return false
+}
+
 func (b *basicBuffer) reset(i int) {
 	*b = (*b)[:i]
 }
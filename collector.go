@@ -0,0 +1,35 @@
+package ipfix
+
+import (
+	"net"
+)
+
+// RecordHandler is called by the collectors for every decoded data record. exporter is the address of
+// the exporting process and header the header of the message that contained the record.
+// Records can be passed on to a channel by sending them from the handler.
+type RecordHandler func(exporter net.Addr, header MessageHeader, record DataRecord)
+
+// ErrorHandler is called by the collectors for messages or data sets that could not be decoded, e.g.,
+// because the template of a data set is unknown.
+type ErrorHandler func(exporter net.Addr, err error)
+
+// dispatchMessage learns the templates of msg and hands all decodable data records to handler.
+func dispatchMessage(cache *TemplateCache, session interface{}, exporter net.Addr, msg *Message, handler RecordHandler, errorHandler ErrorHandler) {
+	cache.Update(session, msg)
+	for _, set := range msg.Sets {
+		set, ok := set.(DataSet)
+		if !ok {
+			continue
+		}
+		records, err := cache.DecodeDataSet(session, msg.ObservationDomainID, set)
+		if err != nil {
+			if errorHandler != nil {
+				errorHandler(exporter, err)
+			}
+			continue
+		}
+		for _, record := range records {
+			handler(exporter, msg.MessageHeader, record)
+		}
+	}
+}
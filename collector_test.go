@@ -0,0 +1,295 @@
+package ipfix_test
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+type collectedRecord struct {
+	exporter net.Addr
+	header   ipfix.MessageHeader
+	record   ipfix.DataRecord
+}
+
+func startUDPCollector(t *testing.T, lifetime time.Duration) (*ipfix.UDPCollector, chan collectedRecord, chan error) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("ListenPacket failed:", err)
+	}
+	records := make(chan collectedRecord, 100)
+	errors := make(chan error, 100)
+	collector := ipfix.MakeUDPCollector(conn, func(exporter net.Addr, header ipfix.MessageHeader, record ipfix.DataRecord) {
+		records <- collectedRecord{exporter, header, record}
+	})
+	collector.ErrorHandler = func(exporter net.Addr, err error) {
+		errors <- err
+	}
+	collector.TemplateLifetime = lifetime
+	go collector.Serve()
+	t.Cleanup(func() { collector.Close() })
+	return collector, records, errors
+}
+
+func dialUDP(t *testing.T, collector *ipfix.UDPCollector) net.Conn {
+	conn, err := net.Dial("udp", collector.Addr().String())
+	if err != nil {
+		t.Fatal("Dial failed:", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// rawDataMessage returns a message holding a single data set, which can refer to any template.
+func rawDataMessage(domain uint32, sequence uint32, id uint16, data []byte) []byte {
+	return rawDataMessageAt(time.Now(), domain, sequence, id, data)
+}
+
+// rawDataMessageAt returns a message with the given export time holding a single data set.
+func rawDataMessageAt(exportTime time.Time, domain uint32, sequence uint32, id uint16, data []byte) []byte {
+	msg := make([]byte, 20+len(data))
+	binary.BigEndian.PutUint16(msg[0:], 10)
+	binary.BigEndian.PutUint16(msg[2:], uint16(len(msg)))
+	binary.BigEndian.PutUint32(msg[4:], uint32(exportTime.Unix()))
+	binary.BigEndian.PutUint32(msg[8:], sequence)
+	binary.BigEndian.PutUint32(msg[12:], domain)
+	binary.BigEndian.PutUint16(msg[16:], id)
+	binary.BigEndian.PutUint16(msg[18:], uint16(4+len(data)))
+	copy(msg[20:], data)
+	return msg
+}
+
+func expectRecord(t *testing.T, records chan collectedRecord, errors chan error, values ...interface{}) collectedRecord {
+	t.Helper()
+	select {
+	case rec := <-records:
+		if len(rec.record.Values) != len(values) {
+			t.Fatalf("Got record %v; wanted %v", rec.record.Values, values)
+		}
+		for i := range values {
+			if rec.record.Values[i] != values[i] {
+				t.Fatalf("Got record %v; wanted %v", rec.record.Values, values)
+			}
+		}
+		return rec
+	case err := <-errors:
+		t.Fatal("Unexpected error:", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timeout waiting for record")
+	}
+	return collectedRecord{}
+}
+
+func expectError(t *testing.T, records chan collectedRecord, errors chan error) error {
+	t.Helper()
+	select {
+	case rec := <-records:
+		t.Fatal("Unexpected record:", rec.record.Values)
+	case err := <-errors:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timeout waiting for error")
+	}
+	return nil
+}
+
+func TestUDPCollector(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+
+	collector, records, errors := startUDPCollector(t, time.Hour)
+	now := time.Now()
+
+	conn := dialUDP(t, collector)
+	stream, _ := ipfix.MakeMessageStream(conn, 1400, 1)
+	id, err := stream.AddTemplate(now, a)
+	if err != nil {
+		t.Fatal("AddTemplate failed:", err)
+	}
+	stream.SendData(now, id, 10)
+	stream.Flush(now)
+	rec := expectRecord(t, records, errors, uint64(10))
+	if rec.header.ObservationDomainID != 1 || rec.record.TemplateID != uint16(id) {
+		t.Fatalf("Wrong header %v or template id %d", rec.header, rec.record.TemplateID)
+	}
+	if rec.exporter.String() != conn.LocalAddr().String() {
+		t.Fatalf("Wrong exporter %s; wanted %s", rec.exporter, conn.LocalAddr())
+	}
+
+	// templates are kept per observation domain
+	conn.Write(rawDataMessage(2, 1, uint16(id), []byte{0, 0, 0, 0, 0, 0, 0, 20}))
+	if _, ok := expectError(t, records, errors).(ipfix.UnknownTemplateError); !ok {
+		t.Fatal("Expected UnknownTemplateError")
+	}
+
+	// and per exporter
+	dialUDP(t, collector).Write(rawDataMessage(1, 1, uint16(id), []byte{0, 0, 0, 0, 0, 0, 0, 30}))
+	if _, ok := expectError(t, records, errors).(ipfix.UnknownTemplateError); !ok {
+		t.Fatal("Expected UnknownTemplateError")
+	}
+}
+
+func TestUDPCollectorRestart(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+	b, _ := ipfix.GetInformationElement("packetDeltaCount")
+
+	collector, records, errors := startUDPCollector(t, time.Hour)
+	now := time.Now()
+
+	conn := dialUDP(t, collector)
+	stream, _ := ipfix.MakeMessageStream(conn, 1400, 1)
+	first, _ := stream.AddTemplate(now, a)
+	second, _ := stream.AddTemplate(now, b)
+	stream.SendData(now, first, 1)
+	stream.SendData(now, second, 2)
+	stream.Flush(now)
+	expectRecord(t, records, errors, uint64(1))
+	expectRecord(t, records, errors, uint64(2))
+	stream.SendData(now, first, 1)
+	stream.Flush(now)
+	expectRecord(t, records, errors, uint64(1))
+
+	// restarted exporter on the same socket starts with sequence number 0 and only a single template
+	later := now.Add(time.Second)
+	stream, _ = ipfix.MakeMessageStream(conn, 1400, 1)
+	stream.AddTemplate(later, b)
+	stream.Flush(later)
+	conn.Write(rawDataMessageAt(later, 1, 0, uint16(second), []byte{0, 0, 0, 0, 0, 0, 0, 3}))
+	if _, ok := expectError(t, records, errors).(ipfix.UnknownTemplateError); !ok {
+		t.Fatal("Expected UnknownTemplateError")
+	}
+	stream.SendData(later, first, 4)
+	stream.Flush(later)
+	expectRecord(t, records, errors, uint64(4))
+}
+
+func TestUDPCollectorReordering(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+
+	collector, records, errors := startUDPCollector(t, time.Hour)
+	now := time.Now()
+
+	conn := dialUDP(t, collector)
+	stream, _ := ipfix.MakeMessageStream(conn, 1400, 1)
+	id, _ := stream.AddTemplate(now, a)
+	stream.SendData(now, id, 1)
+	stream.Flush(now)
+	expectRecord(t, records, errors, uint64(1))
+
+	// datagrams reordered within the same second of export time must not drop the templates
+	conn.Write(rawDataMessageAt(now, 1, 10, uint16(id), []byte{0, 0, 0, 0, 0, 0, 0, 2}))
+	expectRecord(t, records, errors, uint64(2))
+	conn.Write(rawDataMessageAt(now, 1, 5, uint16(id), []byte{0, 0, 0, 0, 0, 0, 0, 3}))
+	expectRecord(t, records, errors, uint64(3))
+	conn.Write(rawDataMessageAt(now, 1, 11, uint16(id), []byte{0, 0, 0, 0, 0, 0, 0, 4}))
+	expectRecord(t, records, errors, uint64(4))
+
+	// a large jump back is a restart even within the same second
+	conn.Write(rawDataMessageAt(now, 1, 11+1<<20, uint16(id), []byte{0, 0, 0, 0, 0, 0, 0, 5}))
+	expectRecord(t, records, errors, uint64(5))
+	conn.Write(rawDataMessageAt(now, 1, 0, uint16(id), []byte{0, 0, 0, 0, 0, 0, 0, 6}))
+	if _, ok := expectError(t, records, errors).(ipfix.UnknownTemplateError); !ok {
+		t.Fatal("Expected UnknownTemplateError")
+	}
+}
+
+func TestUDPCollectorTemplateLifetime(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+
+	collector, records, errors := startUDPCollector(t, 100*time.Millisecond)
+	now := time.Now()
+
+	stream, _ := ipfix.MakeMessageStream(dialUDP(t, collector), 1400, 1)
+	id, _ := stream.AddTemplate(now, a)
+	stream.SendData(now, id, 1)
+	stream.Flush(now)
+	expectRecord(t, records, errors, uint64(1))
+
+	time.Sleep(200 * time.Millisecond)
+	stream.SendData(now, id, 2)
+	stream.Flush(now)
+	if _, ok := expectError(t, records, errors).(ipfix.UnknownTemplateError); !ok {
+		t.Fatal("Expected UnknownTemplateError")
+	}
+}
+
+func TestTCPCollector(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Listen failed:", err)
+	}
+	records := make(chan collectedRecord, 100)
+	errors := make(chan error, 100)
+	collector := ipfix.MakeTCPCollector(l, func(exporter net.Addr, header ipfix.MessageHeader, record ipfix.DataRecord) {
+		records <- collectedRecord{exporter, header, record}
+	}, 1)
+	collector.ErrorHandler = func(exporter net.Addr, err error) {
+		errors <- err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error)
+	go func() { served <- collector.Serve(ctx) }()
+	now := time.Now()
+
+	first, err := net.Dial("tcp", collector.Addr().String())
+	if err != nil {
+		t.Fatal("Dial failed:", err)
+	}
+	defer first.Close()
+	stream, _ := ipfix.MakeMessageStream(first, 0, 1)
+	id, _ := stream.AddTemplate(now, a)
+	stream.SendData(now, id, 1)
+	stream.Flush(now)
+	stream.SendData(now, id, 2)
+	stream.Flush(now)
+	expectRecord(t, records, errors, uint64(1))
+	expectRecord(t, records, errors, uint64(2))
+
+	// the second connection has to wait for the first one to finish and doesn't know its templates
+	second, err := net.Dial("tcp", collector.Addr().String())
+	if err != nil {
+		t.Fatal("Dial failed:", err)
+	}
+	defer second.Close()
+	second.Write(rawDataMessage(1, 0, uint16(id), []byte{0, 0, 0, 0, 0, 0, 0, 3}))
+	select {
+	case <-errors:
+		t.Fatal("Second connection was served while the first one was still open")
+	case <-time.After(100 * time.Millisecond):
+	}
+	first.Close()
+	if _, ok := expectError(t, records, errors).(ipfix.UnknownTemplateError); !ok {
+		t.Fatal("Expected UnknownTemplateError")
+	}
+
+	stream, _ = ipfix.MakeMessageStream(second, 0, 1)
+	id, _ = stream.AddTemplate(now, a)
+	stream.SendData(now, id, 4)
+	stream.Flush(now)
+	expectRecord(t, records, errors, uint64(4))
+
+	cancel()
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Fatal("Serve returned error:", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timeout waiting for Serve to return")
+	}
+	second.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := second.Read(make([]byte, 1)); err != io.EOF {
+		t.Fatal("Expected closed connection; got", err)
+	}
+}
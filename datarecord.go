@@ -3,6 +3,8 @@ package ipfix
 type recordBuffer struct {
 	basicBuffer
 	template int16
+	// strictConversion is set from MessageStream.Strict before a record is assigned
+	strictConversion bool
 }
 
 func makeRecordBuffer(num int) recordBuffer {
@@ -13,6 +15,10 @@ func (b recordBuffer) id() int16 {
 	return b.template
 }
 
+func (b *recordBuffer) strict() bool {
+	return b.strictConversion
+}
+
 func (b *recordBuffer) serializeTo(basicBuffer scratchBuffer) error {
 	buf, err := basicBuffer.append(len(b.basicBuffer))
 	if err != nil {
@@ -22,3 +28,12 @@ func (b *recordBuffer) serializeTo(basicBuffer scratchBuffer) error {
 	b.basicBuffer = b.basicBuffer[:0]
 	return nil
 }
+
+// DataRecord is a decoded data record. Values holds the decoded value for every information element of
+// the template in Elements as returned by Type.Decode; basic lists are returned as []interface{}.
+// Addresses are returned as netip.Addr if TemplateCache.NetIP is set.
+type DataRecord struct {
+	TemplateID uint16
+	Elements   []InformationElement
+	Values     []interface{}
+}
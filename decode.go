@@ -0,0 +1,330 @@
+package ipfix
+
+import (
+	"encoding/binary"
+	"math"
+	"net"
+	"time"
+)
+
+// Decode decodes a single value of this type from b. length is the field length from the template;
+// 0 chooses the default size of the type. If length is VariableLength, b must start with the variable
+// length header according to RFC7011 section 7. b must hold exactly one value.
+//
+// The returned value is an uint64 for unsigned types, int64 for signed types, float64 for float types,
+// bool, net.HardwareAddr, string, []byte for octet arrays, net.IP for addresses, time.Time for the
+// dateTime types, or *big.Int for unsigned256. Reduced size encodings are expanded to the full type.
+func (t Type) Decode(b []byte, length int) (interface{}, error) {
+	value, n, err := t.decodeFrom(b, length)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(b) {
+		return nil, SizeError{t, len(b)}
+	}
+	return value, nil
+}
+
+// decodeFrom decodes a value from the start of b and returns the value together with the number of
+// consumed bytes.
+func (t Type) decodeFrom(b []byte, length int) (interface{}, int, error) {
+	if t < 0 || int(t) >= len(DefaultSize) {
+		return nil, 0, IllegalTypeError(t)
+	}
+	if length == 0 {
+		length = int(DefaultSize[t])
+	}
+	header := 0
+	if length == int(VariableLength) {
+		switch t {
+		case OctetArrayType, StringType, MacAddressType, Ipv4AddressType, Ipv6AddressType:
+		default:
+			return nil, 0, SizeError{t, length}
+		}
+		var err error
+		if length, header, err = decodeVariableLength(t, b); err != nil {
+			return nil, 0, err
+		}
+		b = b[header:]
+	}
+	if len(b) < length {
+		return nil, 0, SizeError{t, len(b)}
+	}
+	b = b[:length]
+	var value interface{}
+	var err error
+	switch t {
+	case OctetArrayType:
+		value = append(make([]byte, 0, length), b...)
+	case StringType:
+		value = string(b)
+	case Ipv4AddressType, Ipv6AddressType:
+		if length != int(DefaultSize[t]) {
+			return nil, 0, SizeError{t, length}
+		}
+		value = net.IP(append([]byte(nil), b...))
+	case MacAddressType:
+		if length != int(DefaultSize[t]) {
+			return nil, 0, SizeError{t, length}
+		}
+		value = net.HardwareAddr(append([]byte(nil), b...))
+	case Unsigned8Type, Unsigned16Type, Unsigned32Type, Unsigned64Type, Signed8Type, Signed16Type, Signed32Type, Signed64Type, BooleanType:
+		value, err = decodeInteger(t, b)
+	case Float32Type, Float64Type:
+		value, err = decodeFloat(t, b)
+	case DateTimeSecondsType, DateTimeMillisecondsType, DateTimeMicrosecondsType, DateTimeNanosecondsType:
+		value, err = decodeDateTime(t, b)
+	case Unsigned256Type:
+		value, err = decodeUnsigned256(b)
+	default:
+		err = IllegalTypeError(t)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return value, header + length, nil
+}
+
+// decodeVariableLength decodes the length header of variable length fields according to RFC7011
+// section 7 and returns the length of the value and the length of the header.
+func decodeVariableLength(t Type, b []byte) (length int, header int, err error) {
+	if len(b) < 1 {
+		return 0, 0, SizeError{t, len(b)}
+	}
+	if b[0] < 255 {
+		return int(b[0]), 1, nil
+	}
+	if len(b) < 3 {
+		return 0, 0, SizeError{t, len(b)}
+	}
+	return int(binary.BigEndian.Uint16(b[1:3])), 3, nil
+}
+
+// decodeUnsigned decodes the big endian integer in b, which can be of any length between 1 and 8.
+func decodeUnsigned(b []byte) (val uint64) {
+	for _, x := range b {
+		val = val<<8 | uint64(x)
+	}
+	return
+}
+
+func decodeInteger(t Type, b []byte) (interface{}, error) {
+	length := len(b)
+	if length < 1 || length > int(DefaultSize[t]) {
+		return nil, SizeError{t, length}
+	}
+	val := decodeUnsigned(b)
+	switch t {
+	case BooleanType:
+		switch val {
+		case 1:
+			return true, nil
+		case 2:
+			return false, nil
+		}
+		return nil, ConversionError{t, val}
+	case Signed8Type, Signed16Type, Signed32Type, Signed64Type:
+		shift := uint(64 - 8*length)
+		return int64(val<<shift) >> shift, nil
+	}
+	return val, nil
+}
+
+func decodeFloat(t Type, b []byte) (interface{}, error) {
+	switch len(b) {
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case 8:
+		if t == Float64Type {
+			return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+		}
+	}
+	return nil, SizeError{t, len(b)}
+}
+
+func decodeDateTime(t Type, b []byte) (interface{}, error) {
+	if len(b) != int(DefaultSize[t]) {
+		return nil, SizeError{t, len(b)}
+	}
+	switch t {
+	case DateTimeSecondsType:
+		return time.Unix(int64(binary.BigEndian.Uint32(b)), 0).UTC(), nil
+	case DateTimeMillisecondsType:
+		ms := binary.BigEndian.Uint64(b)
+		return time.Unix(int64(ms/1e3), int64(ms%1e3)*1e6).UTC(), nil
+	}
+	_ = b[7]
+	// NTP timestamp: 32bit seconds since the NTP epoch + 32bit fraction of a second
+	seconds := binary.BigEndian.Uint32(b[:4]) - ntp2Unix
+	nanoseconds := (uint64(binary.BigEndian.Uint32(b[4:8])) * 1e9) >> 32
+	if t == DateTimeMicrosecondsType {
+		// the fraction only has a resolution of ~.477 microseconds
+		nanoseconds = (nanoseconds + 500) / 1e3 * 1e3
+	}
+	return time.Unix(int64(seconds), int64(nanoseconds)).UTC(), nil
+}
+
+// decoder holds the state for decoding data records
+type decoder struct {
+	// lookup resolves template ids in subTemplateLists and subTemplateMultiLists
+	lookup func(id uint16) ([]InformationElement, error)
+	// netip decodes addresses as netip.Addr instead of net.IP
+	netip bool
+	// registry resolves the list elements of basicLists
+	registry *Registry
+}
+
+// decodeDataFrom decodes a value of this information element from the start of b and returns the
+// value and the number of consumed bytes. Basic lists are decoded into a []interface{}, subTemplateLists
+// and subTemplateMultiLists into a []DataRecord.
+func (ie InformationElement) decodeDataFrom(b []byte, d decoder) (interface{}, int, error) {
+	switch ie.Type {
+	case Ipv4AddressType, Ipv6AddressType:
+		if d.netip {
+			return decodeAddrFrom(ie.Type, b, int(ie.Length))
+		}
+		return ie.Type.decodeFrom(b, int(ie.Length))
+	case BasicListType, SubTemplateListType, SubTemplateMultiListType:
+	default:
+		return ie.Type.decodeFrom(b, int(ie.Length))
+	}
+	length := int(ie.Length)
+	header := 0
+	if ie.Length == VariableLength {
+		var err error
+		if length, header, err = decodeVariableLength(ie.Type, b); err != nil {
+			return nil, 0, err
+		}
+	}
+	if len(b) < header+length {
+		return nil, 0, SizeError{ie.Type, len(b)}
+	}
+	content := b[header : header+length]
+	// Header according to RFC6313: every list starts with the semantic
+	if len(content) < 1 {
+		return nil, 0, SizeError{ie.Type, length}
+	}
+	var value interface{}
+	var err error
+	switch ie.Type {
+	case BasicListType:
+		value, err = decodeBasicList(content[1:], d)
+	case SubTemplateListType:
+		value, err = decodeSubTemplateList(content[1:], d)
+	case SubTemplateMultiListType:
+		value, err = decodeSubTemplateMultiList(content[1:], d)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return value, header + length, nil
+}
+
+func decodeBasicList(b []byte, d decoder) ([]interface{}, error) {
+	// the semantic is followed by the field specifier
+	fields, b, err := decodeFieldSpecifiers(b, 1, basicListID)
+	if err != nil {
+		return nil, err
+	}
+	subie := d.registry.resolveFieldSpecifier(fields[0])
+	var values []interface{}
+	for len(b) > 0 {
+		value, n, err := subie.decodeDataFrom(b, d)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		b = b[n:]
+	}
+	return values, nil
+}
+
+func decodeSubTemplateList(b []byte, d decoder) ([]DataRecord, error) {
+	// the semantic is followed by the template id
+	if len(b) < 2 {
+		return nil, SizeError{SubTemplateListType, len(b)}
+	}
+	id := binary.BigEndian.Uint16(b[0:2])
+	elements, err := d.lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	return decodeRecords(id, elements, b[2:], d, false)
+}
+
+func decodeSubTemplateMultiList(b []byte, d decoder) ([]DataRecord, error) {
+	var records []DataRecord
+	// the semantic is followed by lists of records with template id and length
+	for len(b) > 0 {
+		if len(b) < 4 {
+			return nil, SizeError{SubTemplateMultiListType, len(b)}
+		}
+		id := binary.BigEndian.Uint16(b[0:2])
+		length := int(binary.BigEndian.Uint16(b[2:4]))
+		if length < 4 || length > len(b) {
+			return nil, SizeError{SubTemplateMultiListType, length}
+		}
+		elements, err := d.lookup(id)
+		if err != nil {
+			return nil, err
+		}
+		list, err := decodeRecords(id, elements, b[4:length], d, false)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, list...)
+		b = b[length:]
+	}
+	return records, nil
+}
+
+// minimumLength returns the smallest possible size of a value of this information element.
+func (ie InformationElement) minimumLength() int {
+	if ie.Length == VariableLength {
+		return 1
+	}
+	return int(ie.Length)
+}
+
+// decodeRecords splits the given data set content into data records and decodes them with elements. If
+// padded is true, b is the content of a set, which may end with padding.
+func decodeRecords(id uint16, elements []InformationElement, b []byte, d decoder, padded bool) ([]DataRecord, error) {
+	minimum := 0
+	variable := false
+	for _, element := range elements {
+		minimum += element.minimumLength()
+		variable = variable || element.Length == VariableLength
+	}
+	if minimum == 0 {
+		return nil, nil
+	}
+	var records []DataRecord
+	// anything shorter than the smallest possible record is padding
+	for len(b) >= minimum {
+		// with variable length fields, padding can be longer than the smallest record, but is zero
+		if padded && variable && isPadding(b) {
+			break
+		}
+		values := make([]interface{}, len(elements))
+		for i, element := range elements {
+			value, n, err := element.decodeDataFrom(b, d)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+			b = b[n:]
+		}
+		records = append(records, DataRecord{id, elements, values})
+	}
+	return records, nil
+}
+
+// isPadding returns true if b consists of zero bytes only.
+func isPadding(b []byte) bool {
+	for _, x := range b {
+		if x != 0 {
+			return false
+		}
+	}
+	return true
+}
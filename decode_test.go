@@ -0,0 +1,111 @@
+package ipfix
+
+import (
+	"math"
+	"math/big"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTypeDecode(t *testing.T) {
+	now := time.Date(2018, 01, 01, 12, 30, 15, 123456789, time.UTC)
+	max256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	tests := []struct {
+		t      Type
+		length int
+		value  interface{}
+		want   interface{}
+	}{
+		{OctetArrayType, 0, []byte{1, 2, 3}, []byte{1, 2, 3}},
+		{OctetArrayType, 0, []byte{}, []byte{}},
+		{OctetArrayType, 0, make([]byte, 300), make([]byte, 300)},
+		{OctetArrayType, 4, []byte{1, 2}, []byte{1, 2, 0, 0}},
+		{StringType, 0, "test", "test"},
+		{StringType, 0, strings.Repeat("x", 255), strings.Repeat("x", 255)},
+		{Unsigned8Type, 0, uint8(200), uint64(200)},
+		{Unsigned16Type, 0, 1000, uint64(1000)},
+		{Unsigned32Type, 0, 100000, uint64(100000)},
+		{Unsigned64Type, 0, uint64(math.MaxUint64), uint64(math.MaxUint64)},
+		{Unsigned64Type, 1, 5, uint64(5)},
+		{Unsigned64Type, 3, 0x123456, uint64(0x123456)},
+		{Unsigned64Type, 5, 0x123456789a, uint64(0x123456789a)},
+		{Unsigned64Type, 6, 0x123456789abc, uint64(0x123456789abc)},
+		{Unsigned64Type, 7, 0x123456789abcde, uint64(0x123456789abcde)},
+		{Signed8Type, 0, -5, int64(-5)},
+		{Signed16Type, 0, -1000, int64(-1000)},
+		{Signed32Type, 0, -100000, int64(-100000)},
+		{Signed64Type, 0, int64(math.MinInt64), int64(math.MinInt64)},
+		{Signed64Type, 3, -2, int64(-2)},
+		{Signed64Type, 3, 0x7fffff, int64(0x7fffff)},
+		{Float32Type, 0, 1.5, float64(1.5)},
+		{Float64Type, 8, 1.1, float64(1.1)},
+		{Float64Type, 4, 1.5, float64(1.5)},
+		{BooleanType, 0, true, true},
+		{BooleanType, 0, false, false},
+		{MacAddressType, 0, net.HardwareAddr{1, 2, 3, 4, 5, 6}, net.HardwareAddr{1, 2, 3, 4, 5, 6}},
+		{Ipv4AddressType, 0, net.IP{192, 168, 0, 1}, net.IP{192, 168, 0, 1}},
+		{Ipv6AddressType, 0, net.ParseIP("2001:db8::1"), net.ParseIP("2001:db8::1")},
+		{Ipv4AddressType, int(VariableLength), net.IP{192, 168, 0, 1}, net.IP{192, 168, 0, 1}},
+		{DateTimeSecondsType, 0, now, now.Truncate(time.Second)},
+		{DateTimeMillisecondsType, 0, now, now.Truncate(time.Millisecond)},
+		{DateTimeMicrosecondsType, 0, now.Truncate(time.Microsecond), now.Truncate(time.Microsecond)},
+		{DateTimeNanosecondsType, 0, now, now},
+		{Unsigned256Type, 0, max256, max256},
+		{Unsigned256Type, 0, big.NewInt(5), big.NewInt(5)},
+		{Unsigned256Type, 0, [32]byte{0: 1, 31: 2}, new(big.Int).SetBytes([]byte{0: 1, 31: 2})},
+		{Unsigned256Type, 0, []byte{1, 2}, big.NewInt(0x102)},
+		{Unsigned256Type, 0, uint64(math.MaxUint64), new(big.Int).SetUint64(math.MaxUint64)},
+		{Unsigned256Type, 2, []byte{0, 0, 0, 1, 2}, big.NewInt(0x102)},
+		{Unsigned256Type, 9, big.NewInt(1), big.NewInt(1)},
+	}
+	for _, test := range tests {
+		buffer := makeBasicBuffer(1024)
+		if _, err := test.t.serializeDataTo(buffer, test.value, test.length); err != nil {
+			t.Errorf("%s: serializing %v failed: %s", test.t, test.value, err)
+			continue
+		}
+		got, err := test.t.Decode(*buffer.(*basicBuffer), test.length)
+		if err != nil {
+			t.Errorf("%s: decoding %v failed: %s", test.t, test.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: decoding returned %#v; wanted %#v", test.t, got, test.want)
+		}
+	}
+}
+
+func TestTypeDecodeWrongSize(t *testing.T) {
+	tests := []struct {
+		t      Type
+		length int
+		b      []byte
+	}{
+		{Unsigned16Type, 0, []byte{1}},
+		{Unsigned16Type, 0, []byte{1, 2, 3}},
+		{Unsigned16Type, 3, []byte{1, 2, 3}},
+		{Unsigned64Type, 9, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{Float32Type, 8, []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+		{Float64Type, 6, []byte{1, 2, 3, 4, 5, 6}},
+		{BooleanType, 0, []byte{3}},
+		{MacAddressType, 4, []byte{1, 2, 3, 4}},
+		{Ipv4AddressType, 0, []byte{1, 2, 3}},
+		{Ipv6AddressType, int(VariableLength), []byte{4, 1, 2, 3, 4}},
+		{DateTimeMillisecondsType, 4, []byte{1, 2, 3, 4}},
+		{Unsigned32Type, int(VariableLength), []byte{4, 1, 2, 3, 4}},
+		{StringType, int(VariableLength), []byte{4, 'a', 'b'}},
+		{StringType, int(VariableLength), []byte{0xff, 0}},
+		{OctetArrayType, int(VariableLength), []byte{1, 1, 2}},
+		{Unsigned256Type, 33, make([]byte, 33)},
+		{Unsigned256Type, 0, make([]byte, 31)},
+		{IllegalType, 0, []byte{1}},
+	}
+	for _, test := range tests {
+		if got, err := test.t.Decode(test.b, test.length); err == nil {
+			t.Errorf("%s: decoding % x with length %d returned %#v; expected an error", test.t, test.b, test.length, got)
+		}
+	}
+}
@@ -1,25 +1,45 @@
 /*
-Package ipfix writes ipfix data streams as defined by RFC 7011.
+Package ipfix writes and reads ipfix data streams as defined by RFC 7011.
 
-Currently supported is only writing to an io.Writer, the datatypes from the
-RFC7011 + basic lists from RFC 6313.
-
-Option templates and template recovation are not supported.
+Currently supported is writing to an io.Writer, decoding messages from an io.Reader or a byte
+slice, the datatypes from the RFC7011 + unsigned256 + basic lists, subTemplateLists, and
+subTemplateMultiLists from RFC 6313. Values of unsigned256 can be passed as *big.Int, [32]byte, or
+big endian []byte, and are decoded as *big.Int.
 
 Usage
 
 For exporting ipfix data a MessageStream instance has to be created with MakeMessageStream.
 This stream then provides the two functions AddTemplate for adding templates and SendData for sending
-data, as specified by a template. After all the data has been added with SendData, Flush must be called.
-Full examples are provided at the MakeMessageStream function.
+data, as specified by a template. Options templates (AddOptionsTemplate), template withdrawal
+(WithdrawTemplate), tagged structs (AddStructTemplate and SendStruct), and records generated by
+generate_record.go (SendRecord) are supported as well. After all the data has been added, Flush must be
+called. Full examples are provided at the MakeMessageStream function.
+
+Values that don't fit into the length of an information element result in a RangeError instead of being
+truncated. ReducedSize and InformationElement.ReducedSizeFor choose reduced-size encodings (RFC 7011
+section 6.2), and MessageStream.Strict enables further checks. Address elements also accept netip.Addr
+and netip.Prefix.
+
+MakeUDPExporter and MakeTCPExporter return a UDPExporter and a TCPExporter, which take care of template
+refresh, path mtu, and reconnects for the respective transport.
+
+Messages can be read with a MessageReader created by MakeMessageReader, or decoded with DecodeMessage. A
+TemplateCache collects the templates per transport session and decodes data sets into DataRecords.
+MakeUDPCollector and MakeTCPCollector receive and decode messages from the network.
 
 Information elements can be created either from an iespec (RFC 7373) with MakeIEFromSpec, or by hand
-with NewInformationElement or NewBasicList.
+with NewInformationElement or NewBasicList. Lists of records are created with
+MessageStream.NewSubTemplateList and MessageStream.NewSubTemplateMultiList.
 
-All the information elements as defined by the iana can be loaded with LoadIanaSpec and then accessed
-by name with GetInformationElement.
+All the information elements as defined by the iana can be loaded with LoadIANASpec and then accessed
+by name with GetInformationElement or by id with GetInformationElementByID. Value registries like
+natEvent have generated constants. Partial vendor dictionaries are loaded with LoadYAFSpec,
+LoadNtopSpec, LoadVMwareSpec, LoadCiscoAVCSpec, and LoadBarracudaSpec.
 
 Custom loaders can be created with generate_spec.go or by calling RegisterInformationElement.
+Dictionaries can also be loaded at runtime with Registry.LoadIANAXML, Registry.LoadIESpec, and
+Registry.LoadJSON. The package level functions use the DefaultRegistry; separate registries that are
+layered on top of each other are created with MakeRegistry.
 
 */
 package ipfix
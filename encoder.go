@@ -0,0 +1,51 @@
+package ipfix
+
+// RecordEncoder is a data record that writes itself without any conversions, e.g., a type generated by
+// generate_record.go.
+type RecordEncoder interface {
+	// Elements returns the information elements of the record in template order. The same slice must be
+	// returned on every call.
+	Elements() []InformationElement
+	// Encode writes the record to b and returns the number of written bytes. If b is too short,
+	// io.ErrShortBuffer must be returned.
+	Encode(b []byte) (int, error)
+}
+
+// RecordDecoder is a data record that reads itself without any conversions, e.g., a type generated by
+// generate_record.go.
+type RecordDecoder interface {
+	// Elements returns the information elements of the record in template order.
+	Elements() []InformationElement
+	// Decode reads the record from the start of b and returns the number of consumed bytes. If b is too
+	// short, io.ErrUnexpectedEOF must be returned.
+	Decode(b []byte) (int, error)
+}
+
+// SendRecord sends the given record for the given template id. The elements of the record must match the
+// template, e.g., by creating the template with AddTemplate(now, record.Elements()...). The record is
+// written directly into the buffer of the next data record. If the record does not fit into the mtu,
+// the error of the encoder is returned.
+func (m *MessageStream) SendRecord(now interface{}, template int, record RecordEncoder) error {
+	t := m.lookupTemplate(template)
+	if t == nil {
+		return UnknownTemplateError(template)
+	}
+	elements := record.Elements()
+	if len(elements) == 0 || t.encoder != &elements[0] {
+		if err := t.matches(elements); err != nil {
+			return err
+		}
+		if len(elements) > 0 {
+			t.encoder = &elements[0]
+		}
+	}
+	buffer := &m.currentDataRecord
+	good := len(buffer.basicBuffer)
+	n, err := record.Encode(buffer.basicBuffer[good:cap(buffer.basicBuffer)])
+	if err != nil {
+		return err
+	}
+	buffer.basicBuffer = buffer.basicBuffer[:good+n]
+	buffer.template = t.identifier
+	return m.sendRecord(buffer, now)
+}
@@ -0,0 +1,193 @@
+package ipfix_test
+
+//go:generate go run generate_record.go ipfix_test testRecord record_test.template
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+func makeTestRecord() testRecord {
+	start := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	r := testRecord{
+		OctetDeltaCount:       1 << 40,
+		PacketDeltaCount:      1 << 20,
+		FlowStartSeconds:      start,
+		FlowStartMilliseconds: start.Add(1234 * time.Millisecond),
+		FlowEndMicroseconds:   start.Add(5678901 * time.Microsecond),
+		FlowEndNanoseconds:    start.Add(5 * time.Second),
+		SamplingProbability:   0.25,
+		SamplingProbability2:  0.5,
+		ApplicationName:       "test",
+		InterfaceName:         "eth0\x00\x00\x00\x00",
+		IpClassOfService:      7,
+		MplsTopLabelTTL:       255,
+		TestSigned:            -2,
+		TestBool:              true,
+		TestFloat:             1.5,
+		TestVarOctets:         bytes.Repeat([]byte{0xaa}, 300),
+	}
+	copy(r.SourceIPv4Address[:], net.IP{10, 0, 0, 1})
+	copy(r.DestinationIPv6Address[:], net.ParseIP("2001:db8::1"))
+	copy(r.SourceMacAddress[:], []byte{1, 2, 3, 4, 5, 6})
+	copy(r.TestOctets[:], []byte{9, 8, 7, 6})
+	return r
+}
+
+func TestSendRecord(t *testing.T) {
+	ipfix.LoadIANASpec()
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	record := makeTestRecord()
+
+	// generated records must be encoded like the values
+	encoded := new(bytes.Buffer)
+	msgStream, err := ipfix.MakeMessageStream(encoded, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := msgStream.AddTemplate(now, record.Elements()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msgStream.SendRecord(now, id, &record); err != nil {
+		t.Fatal(err)
+	}
+	msgStream.Flush(now)
+
+	values := new(bytes.Buffer)
+	msgStream, err = ipfix.MakeMessageStream(values, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := msgStream.AddTemplate(now, record.Elements()...); err != nil {
+		t.Fatal(err)
+	}
+	if err := msgStream.SendData(now, id,
+		record.SourceIPv4Address[:], record.DestinationIPv6Address[:], record.OctetDeltaCount, record.PacketDeltaCount,
+		record.FlowStartSeconds, record.FlowStartMilliseconds, record.FlowEndMicroseconds, record.FlowEndNanoseconds,
+		record.SamplingProbability, record.SamplingProbability2, record.ApplicationName, record.InterfaceName,
+		record.SourceMacAddress[:], record.IpClassOfService, record.MplsTopLabelTTL, record.TestSigned, record.TestBool,
+		record.TestOctets[:], record.TestFloat, record.TestVarOctets); err != nil {
+		t.Fatal(err)
+	}
+	msgStream.Flush(now)
+
+	if !bytes.Equal(encoded.Bytes(), values.Bytes()) {
+		t.Fatalf("SendRecord differs from SendData:\n% x\n% x", encoded.Bytes(), values.Bytes())
+	}
+
+	// and decoded again
+	msg, err := ipfix.DecodeMessage(encoded.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := msg.Sets[1].(ipfix.DataSet).Data
+	var decoded testRecord
+	n, err := decoded.Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(data) {
+		t.Errorf("Decode consumed %d bytes of %d", n, len(data))
+	}
+	if !reflect.DeepEqual(decoded, record) {
+		t.Errorf("Decode returned\n%+v\nwant\n%+v", decoded, record)
+	}
+	if _, err := decoded.Decode(data[:n-1]); err != io.ErrUnexpectedEOF {
+		t.Errorf("Decode of short record returned %v", err)
+	}
+
+	// the template must match the record
+	other, err := msgStream.AddTemplate(now, record.Elements()[1:]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msgStream.SendRecord(now, other, &record); err == nil {
+		t.Error("SendRecord succeeded with mismatching template")
+	}
+}
+
+func TestRecordEncoderShortBuffer(t *testing.T) {
+	record := makeTestRecord()
+	b := make([]byte, 1024)
+	n, err := record.Encode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		if _, err := record.Encode(b[:i]); err != io.ErrShortBuffer {
+			t.Fatalf("Encode with %d of %d bytes returned %v", i, n, err)
+		}
+	}
+	b[n-3-300-4-4-1] = 3 // TestBool
+	if _, err := record.Decode(b[:n]); err == nil {
+		t.Error("Decode succeeded with illegal boolean")
+	}
+}
+
+func TestRecordEncoderRange(t *testing.T) {
+	record := makeTestRecord()
+	record.TestSigned = 1 << 23
+	_, err := record.Encode(make([]byte, 1024))
+	if _, ok := err.(ipfix.RangeError); !ok {
+		t.Errorf("Encode of overflowing value returned %v", err)
+	}
+}
+
+func BenchmarkSendRecord(b *testing.B) {
+	ipfix.LoadIANASpec()
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	record := makeTestRecord()
+	record.TestVarOctets = nil
+	msgStream, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	id, err := msgStream.AddTemplate(now, record.Elements()...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := msgStream.SendRecord(now, id, &record); err != nil {
+			b.Fatal(err)
+		}
+	}
+	msgStream.Flush(now)
+}
+
+func BenchmarkSendDataRecord(b *testing.B) {
+	ipfix.LoadIANASpec()
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	record := makeTestRecord()
+	record.TestVarOctets = nil
+	msgStream, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	id, err := msgStream.AddTemplate(now, record.Elements()...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := msgStream.SendData(now, id,
+			record.SourceIPv4Address[:], record.DestinationIPv6Address[:], record.OctetDeltaCount, record.PacketDeltaCount,
+			record.FlowStartSeconds, record.FlowStartMilliseconds, record.FlowEndMicroseconds, record.FlowEndNanoseconds,
+			record.SamplingProbability, record.SamplingProbability2, record.ApplicationName, record.InterfaceName,
+			record.SourceMacAddress[:], record.IpClassOfService, record.MplsTopLabelTTL, record.TestSigned, record.TestBool,
+			record.TestOctets[:], record.TestFloat, record.TestVarOctets); err != nil {
+			b.Fatal(err)
+		}
+	}
+	msgStream.Flush(now)
+}
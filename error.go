@@ -23,6 +23,16 @@ func (e TemplateMismatchError) Error() string {
 	return fmt.Sprintf("ipfix: Template does not match given data. Wanted %d elements, got %d elements", e.required, e.used)
 }
 
+// ElementMismatchError indicates that the information elements of a data record do not match the template
+type ElementMismatchError struct {
+	index      int
+	used, want InformationElement
+}
+
+func (e ElementMismatchError) Error() string {
+	return fmt.Sprintf("ipfix: Template does not match given data. Wanted %s at position %d, got %s", e.want, e.index, e.used)
+}
+
 // BasicListMismatchError indicates that the number of required information elements did not match the number of passed data values.
 type BasicListMismatchError struct {
 	used, required int
@@ -56,6 +66,43 @@ func (e ConversionError) Error() string {
 	return fmt.Sprintf("ipfix: Can't convert %s to %s", reflect.TypeOf(e.have), e.want)
 }
 
+// RangeError indicates that a value is out of the range that can be encoded with the length of the
+// information element
+type RangeError struct {
+	ie       InformationElement
+	value    interface{}
+	min, max interface{}
+}
+
+func (e RangeError) Error() string {
+	return fmt.Sprintf("ipfix: Value %v out of range [%v, %v] of %s", e.value, e.min, e.max, e.ie)
+}
+
+// Element returns the information element the value was meant for.
+func (e RangeError) Element() InformationElement {
+	return e.ie
+}
+
+// Value returns the offending value.
+func (e RangeError) Value() interface{} {
+	return e.value
+}
+
+// Range returns the allowed inclusive range of values.
+func (e RangeError) Range() (min, max interface{}) {
+	return e.min, e.max
+}
+
+// IESpecError indicates a malformed iespec at the given line and column, which are counted from 1
+type IESpecError struct {
+	line, column int
+	reason       string
+}
+
+func (e IESpecError) Error() string {
+	return fmt.Sprintf("ipfix: Could not parse iespec at %d:%d: %s", e.line, e.column, e.reason)
+}
+
 // SizeError indicates that the given size is illegal for the given type
 type SizeError struct {
 	t     Type
@@ -66,6 +113,54 @@ func (e SizeError) Error() string {
 	return fmt.Sprintf("ipfix: Illegal size %d for ipfix type %s", e.given, e.t)
 }
 
+// VersionError indicates that a message header carried a version number other than 10
+type VersionError uint16
+
+func (e VersionError) Error() string {
+	return fmt.Sprintf("ipfix: Unsupported message version %d", int(e))
+}
+
+// MessageLengthError indicates that the message length does not match the available data
+type MessageLengthError struct {
+	length, available int
+}
+
+func (e MessageLengthError) Error() string {
+	return fmt.Sprintf("ipfix: Illegal message length %d; have %d bytes", e.length, e.available)
+}
+
+// MalformedSetError indicates that a set inside a message could not be decoded
+type MalformedSetError struct {
+	id     uint16
+	reason string
+}
+
+func (e MalformedSetError) Error() string {
+	return fmt.Sprintf("ipfix: Malformed set with id %d: %s", e.id, e.reason)
+}
+
+// StructError indicates that a struct type can't be used as data record
+type StructError struct {
+	t      reflect.Type
+	field  string
+	reason string
+}
+
+func (e StructError) Error() string {
+	if e.field == "" {
+		return fmt.Sprintf("ipfix: Can't use %s as data record: %s", e.t, e.reason)
+	}
+	return fmt.Sprintf("ipfix: Can't use field %s of %s as data record: %s", e.field, e.t, e.reason)
+}
+
+// WithdrawalError indicates that templates can't be withdrawn on the given transport, e.g., on UDP
+// according to RFC7011 section 8.4
+type WithdrawalError string
+
+func (e WithdrawalError) Error() string {
+	return fmt.Sprintf("ipfix: Templates must not be withdrawn via %s", string(e))
+}
+
 type ipfixError interface {
 	error
 	bufferFull() bool
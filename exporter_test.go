@@ -0,0 +1,328 @@
+package ipfix_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+// receiveMessage reads a single datagram from conn and returns the decoded message and the number of
+// contained template records.
+func receiveMessage(t *testing.T, conn net.PacketConn) (*ipfix.Message, int) {
+	t.Helper()
+	buf := make([]byte, 65535)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal("ReadFrom failed:", err)
+	}
+	msg, err := ipfix.DecodeMessage(buf[:n])
+	if err != nil {
+		t.Fatal("DecodeMessage failed:", err)
+	}
+	templates := 0
+	for _, set := range msg.Sets {
+		if set, ok := set.(ipfix.TemplateSet); ok {
+			templates += len(set.Records)
+		}
+	}
+	return msg, templates
+}
+
+func dialUDPExporter(t *testing.T) (*ipfix.UDPExporter, net.PacketConn) {
+	collector, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("ListenPacket failed:", err)
+	}
+	t.Cleanup(func() { collector.Close() })
+	conn, err := net.DialUDP("udp", nil, collector.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal("DialUDP failed:", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	exporter, err := ipfix.MakeUDPExporter(conn, 1)
+	if err != nil {
+		t.Fatal("MakeUDPExporter failed:", err)
+	}
+	return exporter, collector
+}
+
+func TestUDPExporterRefreshMessages(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+	b, _ := ipfix.GetInformationElement("packetDeltaCount")
+
+	exporter, collector := dialUDPExporter(t)
+	exporter.TemplateRefreshInterval = 0
+	exporter.TemplateRefreshMessages = 2
+	now := time.Now()
+
+	first, _ := exporter.AddTemplate(now, a)
+	second, _ := exporter.AddTemplate(now, b)
+	expected := []int{2, 0, 2, 0, 2}
+	for i, want := range expected {
+		if err := exporter.SendData(now, first, i); err != nil {
+			t.Fatal("SendData failed:", err)
+		}
+		if err := exporter.SendData(now, second, i); err != nil {
+			t.Fatal("SendData failed:", err)
+		}
+		exporter.Flush(now)
+		if _, templates := receiveMessage(t, collector); templates != want {
+			t.Fatalf("Message %d had %d templates; wanted %d", i, templates, want)
+		}
+	}
+}
+
+func TestUDPExporterRefreshInterval(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+
+	exporter, collector := dialUDPExporter(t)
+	exporter.TemplateRefreshInterval = 100 * time.Millisecond
+	now := time.Now()
+
+	id, _ := exporter.AddTemplate(now, a)
+	exporter.SendData(now, id, 1)
+	exporter.Flush(now)
+	if _, templates := receiveMessage(t, collector); templates != 1 {
+		t.Fatalf("First message had %d templates; wanted 1", templates)
+	}
+	exporter.SendData(now, id, 2)
+	exporter.Flush(now)
+	if _, templates := receiveMessage(t, collector); templates != 0 {
+		t.Fatalf("Second message had %d templates; wanted 0", templates)
+	}
+	// the interval is measured in export time
+	later := now.Add(200 * time.Millisecond)
+	exporter.SendData(later, id, 3)
+	exporter.Flush(later)
+	if _, templates := receiveMessage(t, collector); templates != 1 {
+		t.Fatalf("Message after refresh interval had %d templates; wanted 1", templates)
+	}
+}
+
+func TestUDPExporterRefreshSendPaths(t *testing.T) {
+	ipfix.LoadIANASpec()
+	type flow struct {
+		Bytes uint64 `ipfix:"octetDeltaCount"`
+	}
+	record := makeTestRecord()
+
+	exporter, collector := dialUDPExporter(t)
+	exporter.TemplateRefreshInterval = time.Minute
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+
+	structID, _ := exporter.AddStructTemplate(now, flow{})
+	recordID, _ := exporter.AddTemplate(now, record.Elements()...)
+	exporter.Flush(now)
+	if _, templates := receiveMessage(t, collector); templates != 2 {
+		t.Fatalf("First message had %d templates; wanted 2", templates)
+	}
+	sends := []func(now time.Time) error{
+		func(now time.Time) error { return exporter.SendStruct(now, structID, &flow{1}) },
+		func(now time.Time) error { return exporter.SendRecord(now, recordID, &record) },
+	}
+	for i, send := range sends {
+		now = now.Add(time.Minute)
+		if err := send(now); err != nil {
+			t.Fatal("send failed:", err)
+		}
+		exporter.Flush(now)
+		if _, templates := receiveMessage(t, collector); templates != 2 {
+			t.Fatalf("Message %d after refresh interval had %d templates; wanted 2", i, templates)
+		}
+	}
+}
+
+func TestUDPExporterWithdraw(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+
+	exporter, _ := dialUDPExporter(t)
+	now := time.Now()
+
+	id, _ := exporter.AddTemplate(now, a)
+	if _, ok := exporter.WithdrawTemplate(now, id).(ipfix.WithdrawalError); !ok {
+		t.Error("WithdrawTemplate didn't fail with WithdrawalError")
+	}
+	if _, ok := exporter.WithdrawAllTemplates(now).(ipfix.WithdrawalError); !ok {
+		t.Error("WithdrawAllTemplates didn't fail with WithdrawalError")
+	}
+	if err := exporter.SendData(now, id, 1); err != nil {
+		t.Error("SendData failed after refused withdrawal:", err)
+	}
+}
+
+func TestUDPExporterMTU(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+
+	exporter, collector := dialUDPExporter(t)
+	now := time.Now()
+
+	// all records must end up in datagrams that can be sent
+	id, _ := exporter.AddTemplate(now, a)
+	for i := 0; i < 10000; i++ {
+		if err := exporter.SendData(now, id, i); err != nil {
+			t.Fatal("SendData failed:", err)
+		}
+	}
+	exporter.Flush(now)
+	received := 0
+	for received < 10000 {
+		msg, _ := receiveMessage(t, collector)
+		for _, set := range msg.Sets {
+			if set, ok := set.(ipfix.DataSet); ok {
+				received += len(set.Data) / 8
+			}
+		}
+	}
+}
+
+func startTCPCollector(t *testing.T, address string, records chan collectedRecord, errors chan error) (*ipfix.TCPCollector, context.CancelFunc, chan error) {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		t.Fatal("Listen failed:", err)
+	}
+	collector := ipfix.MakeTCPCollector(l, func(exporter net.Addr, header ipfix.MessageHeader, record ipfix.DataRecord) {
+		records <- collectedRecord{exporter, header, record}
+	}, 0)
+	collector.ErrorHandler = func(exporter net.Addr, err error) {
+		errors <- err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- collector.Serve(ctx) }()
+	t.Cleanup(cancel)
+	return collector, cancel, served
+}
+
+func testTCPExporterReconnect(t *testing.T, policy ipfix.DisconnectPolicy) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+
+	records := make(chan collectedRecord, 1000)
+	errors := make(chan error, 100)
+	collector, stop, served := startTCPCollector(t, "127.0.0.1:0", records, errors)
+	address := collector.Addr().String()
+
+	exporter, err := ipfix.MakeTCPExporter(address, 0, 1)
+	if err != nil {
+		t.Fatal("MakeTCPExporter failed:", err)
+	}
+	defer exporter.Close(time.Now())
+	exporter.Policy = policy
+	// buffering must work without setting BufferSize
+	exporter.BufferSize = 0
+	exporter.MinBackoff = 10 * time.Millisecond
+	exporter.MaxBackoff = 10 * time.Millisecond
+	states := make(chan ipfix.ConnectionState, 100)
+	exporter.StateHandler = func(state ipfix.ConnectionState, err error) {
+		states <- state
+	}
+	now := time.Now()
+
+	id, _ := exporter.AddTemplate(now, a)
+	exporter.SendData(now, id, 1)
+	exporter.Flush(now)
+	expectRecord(t, records, errors, uint64(1))
+	if state := <-states; state != ipfix.Connected {
+		t.Fatal("Expected connected state; got", state)
+	}
+
+	// stop the collector and write until the exporter notices
+	stop()
+	<-served
+	var pending []uint64
+	for i := uint64(100); exporter.State() == ipfix.Connected; i++ {
+		if i > 1000 {
+			t.Fatal("Exporter didn't notice the closed connection")
+		}
+		time.Sleep(10 * time.Millisecond)
+		exporter.SendData(now, id, i)
+		exporter.Flush(now)
+		if exporter.State() == ipfix.Disconnected {
+			pending = append(pending, i)
+		}
+	}
+	if state := <-states; state != ipfix.Disconnected {
+		t.Fatal("Expected disconnected state; got", state)
+	}
+	for i := uint64(2000); i < 2005; i++ {
+		exporter.SendData(now, id, i)
+		exporter.Flush(now)
+		pending = append(pending, i)
+	}
+
+	// restart the collector; the new session only knows the replayed templates
+	startTCPCollector(t, address, records, errors)
+	time.Sleep(20 * time.Millisecond)
+	exporter.SendData(now, id, 3000)
+	exporter.Flush(now)
+	if policy == ipfix.DropWhileDisconnected {
+		if exporter.DroppedMessages() != len(pending) {
+			t.Fatalf("Dropped %d messages; expected %d", exporter.DroppedMessages(), len(pending))
+		}
+		pending = nil
+	}
+	for _, value := range append(pending, 3000) {
+		expectRecord(t, records, errors, value)
+	}
+	if exporter.State() != ipfix.Connected {
+		t.Fatal("Exporter didn't reconnect")
+	}
+}
+
+func TestTCPExporterBuffer(t *testing.T) {
+	testTCPExporterReconnect(t, ipfix.BufferWhileDisconnected)
+}
+
+func TestTCPExporterDrop(t *testing.T) {
+	testTCPExporterReconnect(t, ipfix.DropWhileDisconnected)
+}
+
+func TestTCPExporterDropSequence(t *testing.T) {
+	ipfix.LoadIANASpec()
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+
+	// reserve an address without a collector
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Listen failed:", err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	exporter, err := ipfix.MakeTCPExporter(address, 0, 1)
+	if err != nil {
+		t.Fatal("MakeTCPExporter failed:", err)
+	}
+	defer exporter.Close(time.Now())
+	exporter.MinBackoff = time.Millisecond
+	exporter.MaxBackoff = time.Millisecond
+	now := time.Now()
+
+	id, _ := exporter.AddTemplate(now, a)
+	for i := 0; i < 5; i++ {
+		exporter.SendData(now, id, i)
+		exporter.Flush(now)
+	}
+	if exporter.DroppedMessages() != 5 {
+		t.Fatalf("Dropped %d messages; expected 5", exporter.DroppedMessages())
+	}
+
+	// the dropped records are not counted by the sequence number
+	records := make(chan collectedRecord, 10)
+	errors := make(chan error, 10)
+	startTCPCollector(t, address, records, errors)
+	time.Sleep(10 * time.Millisecond)
+	exporter.SendData(now, id, 3000)
+	exporter.Flush(now)
+	if rec := expectRecord(t, records, errors, uint64(3000)); rec.header.SequenceNumber != 0 {
+		t.Errorf("Sequence number after dropped messages is %d; expected 0", rec.header.SequenceNumber)
+	}
+}
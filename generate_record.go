@@ -0,0 +1,449 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/CN-TU/go-ipfix"
+)
+
+// field is a field of the generated record
+type field struct {
+	ie     ipfix.InformationElement
+	name   string
+	goType string
+}
+
+func (f field) variable() bool {
+	return f.ie.Length == ipfix.VariableLength
+}
+
+// generator writes the code for a record type
+type generator struct {
+	bytes.Buffer
+	pkg      string
+	typeName string
+	elements string
+	fields   []field
+	imports  map[string]bool
+}
+
+func main() {
+	if len(os.Args) != 4 {
+		log.Panicf("Usage: %s Package Typename file\n", os.Args[0])
+	}
+	pkg := os.Args[1]
+	typeName := os.Args[2]
+	inputName := os.Args[3]
+	if r, _ := utf8.DecodeRuneInString(typeName); r == utf8.RuneError || !unicode.IsLetter(r) {
+		log.Panicln("Typename must start with a legal UTF-8 letter")
+	}
+	outputName := strings.TrimSuffix(inputName, filepath.Ext(inputName)) + ".go"
+
+	input, err := os.Open(inputName)
+	if err != nil {
+		log.Panicln("Couldn't open input file", inputName, err)
+	}
+	ipfix.LoadIANASpec()
+	g := generator{
+		pkg:      pkg,
+		typeName: typeName,
+		imports:  map[string]bool{"io": true},
+	}
+	spec, err := ioutil.ReadAll(input)
+	if err != nil {
+		log.Panic(err)
+	}
+	elements, err := ipfix.ParseIESpecs(spec)
+	if err != nil {
+		log.Panic(err)
+	}
+	for _, ie := range elements {
+		g.addField(ie)
+	}
+	input.Close()
+	if len(g.fields) == 0 {
+		log.Panicln("Template", inputName, "has no information elements")
+	}
+
+	code, err := format.Source(g.generate())
+	if err != nil {
+		log.Panicln("Generated invalid code", err)
+	}
+	if err := ioutil.WriteFile(outputName, code, 0666); err != nil {
+		log.Panicln("Couldn't write output file", outputName, err)
+	}
+}
+
+// addField adds a field for the given information element and checks that the length can be used with
+// the data type.
+func (g *generator) addField(ie ipfix.InformationElement) {
+	length := int(ie.Length)
+	size := int(ipfix.DefaultSize[ie.Type])
+	var goType string
+	switch ie.Type {
+	case ipfix.Unsigned8Type, ipfix.Unsigned16Type, ipfix.Unsigned32Type, ipfix.Unsigned64Type:
+		// reduced-size encoding uses the smallest integer that can hold the value
+		goType = fmt.Sprintf("uint%d", integerBits(length))
+		if length < 1 || length > size {
+			length = -1
+		}
+	case ipfix.Signed8Type, ipfix.Signed16Type, ipfix.Signed32Type, ipfix.Signed64Type:
+		goType = fmt.Sprintf("int%d", integerBits(length))
+		if length < 1 || length > size {
+			length = -1
+		}
+	case ipfix.Float32Type:
+		goType = "float32"
+		if length != 4 {
+			length = -1
+		}
+	case ipfix.Float64Type:
+		goType = "float64"
+		if length == 4 {
+			goType = "float32"
+		} else if length != 8 {
+			length = -1
+		}
+	case ipfix.BooleanType:
+		goType = "bool"
+		if length != 1 {
+			length = -1
+		}
+	case ipfix.MacAddressType, ipfix.Ipv4AddressType, ipfix.Ipv6AddressType, ipfix.Unsigned256Type:
+		goType = fmt.Sprintf("[%d]byte", size)
+		if length != size {
+			length = -1
+		}
+	case ipfix.StringType:
+		goType = "string"
+	case ipfix.OctetArrayType:
+		goType = "[]byte"
+		if ie.Length != ipfix.VariableLength {
+			goType = fmt.Sprintf("[%d]byte", length)
+		}
+	case ipfix.DateTimeSecondsType, ipfix.DateTimeMillisecondsType, ipfix.DateTimeMicrosecondsType, ipfix.DateTimeNanosecondsType:
+		goType = "time.Time"
+		g.imports["time"] = true
+		if length != size {
+			length = -1
+		}
+	default:
+		log.Panicf("Information element %s has unsupported type %s\n", ie.Name, ie.Type)
+	}
+	if length < 0 {
+		log.Panicf("Information element %s has illegal length %d for type %s\n", ie.Name, ie.Length, ie.Type)
+	}
+	r, n := utf8.DecodeRuneInString(ie.Name)
+	name := string(unicode.ToUpper(r)) + ie.Name[n:]
+	for _, f := range g.fields {
+		if f.name == name {
+			log.Panicf("Information element %s is used more than once\n", ie.Name)
+		}
+	}
+	g.fields = append(g.fields, field{ie, name, goType})
+}
+
+// integerBits returns the size of the smallest go integer that can hold length bytes
+func integerBits(length int) int {
+	switch {
+	case length <= 1:
+		return 8
+	case length <= 2:
+		return 16
+	case length <= 4:
+		return 32
+	}
+	return 64
+}
+
+// typeConstant returns the name of the constant for the given type
+func typeConstant(t ipfix.Type) string {
+	name := t.String()
+	r, n := utf8.DecodeRuneInString(name)
+	return "ipfix." + string(unicode.ToUpper(r)) + name[n:] + "Type"
+}
+
+// minLength returns the minimum length of the fields starting at index start
+func (g *generator) minLength(start int) (length int) {
+	for _, f := range g.fields[start:] {
+		if f.variable() {
+			length++
+		} else {
+			length += int(f.ie.Length)
+		}
+	}
+	return
+}
+
+func (g *generator) generate() []byte {
+	r, n := utf8.DecodeRuneInString(g.typeName)
+	g.elements = string(unicode.ToLower(r)) + g.typeName[n:] + "Elements"
+
+	names := make([]string, len(g.fields))
+	for i, f := range g.fields {
+		names[i] = f.ie.Name
+	}
+	fmt.Fprintf(g, "var %s = []ipfix.InformationElement{\n", g.elements)
+	for _, f := range g.fields {
+		length := strconv.Itoa(int(f.ie.Length))
+		if f.variable() {
+			length = "ipfix.VariableLength"
+		}
+		fmt.Fprintf(g, "ipfix.NewInformationElement(%q, %d, %d, %s, %s),\n", f.ie.Name, f.ie.Pen, f.ie.ID, typeConstant(f.ie.Type), length)
+	}
+	fmt.Fprintf(g, "}\n\n")
+
+	fmt.Fprintf(g, "// %s is a data record with the information elements %s.\n", g.typeName, strings.Join(names, ", "))
+	fmt.Fprintf(g, "type %s struct {\n", g.typeName)
+	for _, f := range g.fields {
+		fmt.Fprintf(g, "%s %s\n", f.name, f.goType)
+	}
+	fmt.Fprintf(g, "}\n\n")
+
+	fmt.Fprintf(g, "// Elements returns the information elements of %s in template order.\n", g.typeName)
+	fmt.Fprintf(g, "func (*%s) Elements() []ipfix.InformationElement {\nreturn %s\n}\n\n", g.typeName, g.elements)
+
+	fmt.Fprintf(g, "// Encode writes the record to b and returns the number of written bytes. If b is too short,\n")
+	fmt.Fprintf(g, "// io.ErrShortBuffer is returned.\n")
+	fmt.Fprintf(g, "func (r *%s) Encode(b []byte) (int, error) {\n", g.typeName)
+	fmt.Fprintf(g, "if len(b) < %d {\nreturn 0, io.ErrShortBuffer\n}\n", g.minLength(0))
+	fmt.Fprintf(g, "o := 0\n")
+	for i, f := range g.fields {
+		g.encodeField(i, f, g.minLength(i+1))
+	}
+	fmt.Fprintf(g, "return o, nil\n}\n\n")
+
+	fmt.Fprintf(g, "// Decode reads the record from the start of b and returns the number of consumed bytes. If b is\n")
+	fmt.Fprintf(g, "// too short, io.ErrUnexpectedEOF is returned.\n")
+	fmt.Fprintf(g, "func (r *%s) Decode(b []byte) (int, error) {\n", g.typeName)
+	fmt.Fprintf(g, "if len(b) < %d {\nreturn 0, io.ErrUnexpectedEOF\n}\n", g.minLength(0))
+	fmt.Fprintf(g, "o := 0\n")
+	for i, f := range g.fields {
+		g.decodeField(f, g.minLength(i+1))
+	}
+	fmt.Fprintf(g, "return o, nil\n}\n")
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "package %s\n\n// GENERATED BY generate_record; DO NOT CHANGE!\n\nimport (\n", g.pkg)
+	for _, imp := range []string{"encoding/binary", "io", "math", "time"} {
+		if g.imports[imp] {
+			fmt.Fprintf(&out, "%q\n", imp)
+		}
+	}
+	fmt.Fprintf(&out, "\n\"github.com/CN-TU/go-ipfix\"\n)\n\n")
+	out.Write(g.Bytes())
+	return out.Bytes()
+}
+
+// encodeUnsigned writes code for writing the integer expression x of type typ with the given length
+func (g *generator) encodeUnsigned(x, typ string, length int) {
+	switch length {
+	case 1:
+		if typ != "uint8" {
+			x = fmt.Sprintf("byte(%s)", x)
+		}
+		fmt.Fprintf(g, "b[o] = %s\n", x)
+	case 2, 4, 8:
+		g.imports["encoding/binary"] = true
+		if bits := fmt.Sprintf("uint%d", length*8); typ != bits {
+			x = fmt.Sprintf("%s(%s)", bits, x)
+		}
+		fmt.Fprintf(g, "binary.BigEndian.PutUint%d(b[o:], %s)\n", length*8, x)
+	default:
+		for i := 0; i < length; i++ {
+			if shift := 8 * (length - 1 - i); shift > 0 {
+				fmt.Fprintf(g, "b[o+%d] = byte(%s >> %d)\n", i, x, shift)
+			} else {
+				fmt.Fprintf(g, "b[o+%d] = byte(%s)\n", i, x)
+			}
+		}
+	}
+}
+
+// decodeUnsigned returns an expression and its type for reading an integer with the given length
+func (g *generator) decodeUnsigned(length int) (string, string) {
+	switch length {
+	case 1:
+		return "b[o]", "uint8"
+	case 2, 4, 8:
+		g.imports["encoding/binary"] = true
+		return fmt.Sprintf("binary.BigEndian.Uint%d(b[o:])", length*8), fmt.Sprintf("uint%d", length*8)
+	}
+	parts := make([]string, length)
+	for i := range parts {
+		if shift := 8 * (length - 1 - i); shift > 0 {
+			parts[i] = fmt.Sprintf("uint64(b[o+%d])<<%d", i, shift)
+		} else {
+			parts[i] = fmt.Sprintf("uint64(b[o+%d])", i)
+		}
+	}
+	return strings.Join(parts, " | "), "uint64"
+}
+
+// convert returns x of type typ converted to type to
+func convert(x, typ, to string) string {
+	if typ == to {
+		return x
+	}
+	return fmt.Sprintf("%s(%s)", to, x)
+}
+
+// encodeField writes code for encoding f, the field with the given index. rest is the minimum length of
+// the following fields.
+func (g *generator) encodeField(index int, f field, rest int) {
+	x := "r." + f.name
+	length := int(f.ie.Length)
+	switch f.ie.Type {
+	case ipfix.Unsigned8Type, ipfix.Unsigned16Type, ipfix.Unsigned32Type, ipfix.Unsigned64Type,
+		ipfix.Signed8Type, ipfix.Signed16Type, ipfix.Signed32Type, ipfix.Signed64Type:
+		if bits := 8 * length; bits != integerBits(length) {
+			// the go type is bigger than the reduced-size encoding
+			if f.ie.Type == ipfix.Unsigned8Type || f.ie.Type == ipfix.Unsigned16Type || f.ie.Type == ipfix.Unsigned32Type || f.ie.Type == ipfix.Unsigned64Type {
+				fmt.Fprintf(g, "if %s >= 1<<%d {\n", x, bits)
+			} else {
+				fmt.Fprintf(g, "if %s < -1<<%d || %s >= 1<<%d {\n", x, bits-1, x, bits-1)
+			}
+			fmt.Fprintf(g, "return 0, %s[%d].CheckValue(%s)\n}\n", g.elements, index, x)
+		}
+		g.encodeUnsigned(x, f.goType, length)
+	case ipfix.BooleanType:
+		fmt.Fprintf(g, "if %s {\nb[o] = 1\n} else {\nb[o] = 2\n}\n", x)
+	case ipfix.Float32Type:
+		g.imports["math"] = true
+		g.encodeUnsigned(fmt.Sprintf("math.Float32bits(%s)", x), "uint32", 4)
+	case ipfix.Float64Type:
+		g.imports["math"] = true
+		if length == 4 {
+			g.encodeUnsigned(fmt.Sprintf("math.Float32bits(%s)", x), "uint32", 4)
+		} else {
+			g.encodeUnsigned(fmt.Sprintf("math.Float64bits(%s)", x), "uint64", 8)
+		}
+	case ipfix.MacAddressType, ipfix.Ipv4AddressType, ipfix.Ipv6AddressType, ipfix.Unsigned256Type:
+		fmt.Fprintf(g, "copy(b[o:o+%d], %s[:])\n", length, x)
+	case ipfix.OctetArrayType, ipfix.StringType:
+		if f.variable() {
+			g.imports["encoding/binary"] = true
+			fmt.Fprintf(g, "if l := len(%s); l < 255 {\n", x)
+			fmt.Fprintf(g, "if len(b)-o < 1+l+%d {\nreturn 0, io.ErrShortBuffer\n}\n", rest)
+			fmt.Fprintf(g, "b[o] = byte(l)\no++\n")
+			fmt.Fprintf(g, "} else {\n")
+			fmt.Fprintf(g, "if l > 65535 || len(b)-o < 3+l+%d {\nreturn 0, io.ErrShortBuffer\n}\n", rest)
+			fmt.Fprintf(g, "b[o] = 0xff\nbinary.BigEndian.PutUint16(b[o+1:], uint16(l))\no += 3\n")
+			fmt.Fprintf(g, "}\n")
+			fmt.Fprintf(g, "o += copy(b[o:], %s)\n", x)
+			return
+		}
+		if f.ie.Type == ipfix.OctetArrayType {
+			fmt.Fprintf(g, "copy(b[o:o+%d], %s[:])\n", length, x)
+		} else {
+			fmt.Fprintf(g, "for i := o + copy(b[o:o+%d], %s); i < o+%d; i++ {\nb[i] = 0\n}\n", length, x, length)
+		}
+	case ipfix.DateTimeSecondsType:
+		g.encodeUnsigned(fmt.Sprintf("%s.Unix()", x), "int64", 4)
+	case ipfix.DateTimeMillisecondsType:
+		g.encodeUnsigned(fmt.Sprintf("uint64(%s.Unix())*1e3+uint64(%s.Nanosecond())/1e6", x, x), "uint64", 8)
+	case ipfix.DateTimeMicrosecondsType, ipfix.DateTimeNanosecondsType:
+		g.imports["encoding/binary"] = true
+		// NTP timestamp: 32bit seconds since the NTP epoch + 32bit fraction of a second
+		fmt.Fprintf(g, "binary.BigEndian.PutUint32(b[o:], uint32(%s.Unix())+0x83AA7E80)\n", x)
+		if f.ie.Type == ipfix.DateTimeMicrosecondsType {
+			fmt.Fprintf(g, "binary.BigEndian.PutUint32(b[o+4:], uint32((uint64(%s.Nanosecond())<<32)/1e9)&0xFFFFF800)\n", x)
+		} else {
+			fmt.Fprintf(g, "binary.BigEndian.PutUint32(b[o+4:], uint32((uint64(%s.Nanosecond())<<32)/1e9)+1)\n", x)
+		}
+	}
+	g.advance(length)
+}
+
+// decodeField writes code for decoding f. rest is the minimum length of the following fields.
+func (g *generator) decodeField(f field, rest int) {
+	x := "r." + f.name
+	length := int(f.ie.Length)
+	size := int(ipfix.DefaultSize[f.ie.Type])
+	switch f.ie.Type {
+	case ipfix.Unsigned8Type, ipfix.Unsigned16Type, ipfix.Unsigned32Type, ipfix.Unsigned64Type:
+		value, typ := g.decodeUnsigned(length)
+		fmt.Fprintf(g, "%s = %s\n", x, convert(value, typ, f.goType))
+	case ipfix.Signed8Type, ipfix.Signed16Type, ipfix.Signed32Type, ipfix.Signed64Type:
+		value, typ := g.decodeUnsigned(length)
+		if length == size {
+			fmt.Fprintf(g, "%s = %s(%s)\n", x, f.goType, value)
+		} else {
+			// sign extension of reduced size encoding
+			shift := 64 - 8*length
+			value = fmt.Sprintf("int64((%s)<<%d) >> %d", convert(value, typ, "uint64"), shift, shift)
+			fmt.Fprintf(g, "%s = %s\n", x, convert(value, "int64", f.goType))
+		}
+	case ipfix.BooleanType:
+		fmt.Fprintf(g, "switch b[o] {\ncase 1:\n%s = true\ncase 2:\n%s = false\ndefault:\n", x, x)
+		fmt.Fprintf(g, "_, err := ipfix.BooleanType.Decode(b[o:o+1], 1)\nreturn 0, err\n}\n")
+	case ipfix.Float32Type:
+		value, _ := g.decodeUnsigned(4)
+		fmt.Fprintf(g, "%s = math.Float32frombits(%s)\n", x, value)
+	case ipfix.Float64Type:
+		value, _ := g.decodeUnsigned(length)
+		if length == 4 {
+			fmt.Fprintf(g, "%s = math.Float32frombits(%s)\n", x, value)
+		} else {
+			fmt.Fprintf(g, "%s = math.Float64frombits(%s)\n", x, value)
+		}
+	case ipfix.MacAddressType, ipfix.Ipv4AddressType, ipfix.Ipv6AddressType, ipfix.Unsigned256Type:
+		fmt.Fprintf(g, "copy(%s[:], b[o:o+%d])\n", x, length)
+	case ipfix.OctetArrayType, ipfix.StringType:
+		if f.variable() {
+			g.imports["encoding/binary"] = true
+			fmt.Fprintf(g, "{\nl := int(b[o])\no++\n")
+			fmt.Fprintf(g, "if l == 255 {\nif len(b)-o < 2 {\nreturn 0, io.ErrUnexpectedEOF\n}\n")
+			fmt.Fprintf(g, "l = int(binary.BigEndian.Uint16(b[o:]))\no += 2\n}\n")
+			fmt.Fprintf(g, "if len(b)-o < l+%d {\nreturn 0, io.ErrUnexpectedEOF\n}\n", rest)
+			if f.ie.Type == ipfix.OctetArrayType {
+				fmt.Fprintf(g, "%s = append(%s[:0], b[o:o+l]...)\n", x, x)
+			} else {
+				fmt.Fprintf(g, "%s = string(b[o : o+l])\n", x)
+			}
+			fmt.Fprintf(g, "o += l\n}\n")
+			return
+		}
+		if f.ie.Type == ipfix.OctetArrayType {
+			fmt.Fprintf(g, "copy(%s[:], b[o:o+%d])\n", x, length)
+		} else {
+			fmt.Fprintf(g, "%s = string(b[o : o+%d])\n", x, length)
+		}
+	case ipfix.DateTimeSecondsType:
+		value, _ := g.decodeUnsigned(4)
+		fmt.Fprintf(g, "%s = time.Unix(int64(%s), 0).UTC()\n", x, value)
+	case ipfix.DateTimeMillisecondsType:
+		value, _ := g.decodeUnsigned(8)
+		fmt.Fprintf(g, "{\nms := %s\n%s = time.Unix(int64(ms/1e3), int64(ms%%1e3)*1e6).UTC()\n}\n", value, x)
+	case ipfix.DateTimeMicrosecondsType, ipfix.DateTimeNanosecondsType:
+		g.imports["encoding/binary"] = true
+		fmt.Fprintf(g, "{\nns := (uint64(binary.BigEndian.Uint32(b[o+4:])) * 1e9) >> 32\n")
+		if f.ie.Type == ipfix.DateTimeMicrosecondsType {
+			// the fraction only has a resolution of ~.477 microseconds
+			fmt.Fprintf(g, "ns = (ns + 500) / 1e3 * 1e3\n")
+		}
+		fmt.Fprintf(g, "%s = time.Unix(int64(binary.BigEndian.Uint32(b[o:])-0x83AA7E80), int64(ns)).UTC()\n}\n", x)
+	}
+	g.advance(length)
+}
+
+// advance writes code for advancing the offset by length
+func (g *generator) advance(length int) {
+	if length == 1 {
+		fmt.Fprintf(g, "o++\n")
+	} else {
+		fmt.Fprintf(g, "o += %d\n", length)
+	}
+}
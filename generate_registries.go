@@ -0,0 +1,232 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/CN-TU/go-ipfix"
+)
+
+// registry describes an iana sub-registry holding the values of an information element
+type registry struct {
+	// id is the id of the registry in the xml file
+	id string
+	// typeName is the name of the generated type; constants are prefixed with prefix
+	typeName, prefix string
+	// element is the name of the iana information element using the registry
+	element string
+}
+
+//SMELL: hardcoded iana stuff
+var registries = []registry{
+	{"ipfix-mpls-label-type", "MplsLabelType", "MplsLabel", "mplsTopLabelType"},
+	{"forwarding-status", "ForwardingStatus", "ForwardingStatus", "forwardingStatus"},
+	{"classification-engine-ids", "ClassificationEngineID", "ClassificationEngine", "classificationEngineId"},
+	{"ipfix-nat-event-type", "NatEvent", "NatEvent", "natEvent"},
+	{"ipfix-flowselectoralgorithm", "FlowSelectorAlgorithm", "FlowSelector", "flowSelectorAlgorithm"},
+	{"ipfix-nat-quota-exceeded-event", "NatQuotaExceededEvent", "NatQuotaExceeded", "natQuotaExceededEvent"},
+	{"ipfix-nat-threshold-event", "NatThresholdEvent", "NatThreshold", "natThresholdEvent"},
+}
+
+// names replaces descriptions that are sentences instead of names
+var names = map[string]map[uint64]string{
+	"classification-engine-ids": {21: "Qosmos ixEngine"},
+}
+
+// value is a single named value of a registry
+type value struct {
+	value      uint64
+	name       string
+	identifier string
+}
+
+// xmlRegistry is a registry in the iana xml file. Registries can contain sub-registries, whose titles
+// are prefixed to the names of their values.
+type xmlRegistry struct {
+	ID         string        `xml:"id,attr"`
+	Title      string        `xml:"title"`
+	Records    []xmlRecord   `xml:"record"`
+	Registries []xmlRegistry `xml:"registry"`
+}
+
+type xmlRecord struct {
+	Value       string `xml:"value"`
+	Description string `xml:"description"`
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Panicf("Usage: %s spec.xml output.go\n", os.Args[0])
+	}
+	input, err := ioutil.ReadFile(os.Args[1])
+	if err != nil {
+		log.Panicln("Couldn't read input file", os.Args[1], err)
+	}
+	var root xmlRegistry
+	if err := xml.Unmarshal(input, &root); err != nil {
+		log.Panicln("Couldn't parse input file", os.Args[1], err)
+	}
+	ipfix.LoadIANASpec()
+
+	g := new(bytes.Buffer)
+	fmt.Fprintf(g, `package ipfix
+
+// GENERATED BY generate_registries; DO NOT CHANGE!
+
+import "strconv"
+`)
+	var elements []string
+	for _, r := range registries {
+		x := findRegistry(root, r.id)
+		if x == nil {
+			log.Panicln("Registry", r.id, "not found")
+		}
+		ie, err := ipfix.GetInformationElement(r.element)
+		if err != nil {
+			log.Panicln(err)
+		}
+		values := collectValues(*x, "")
+		for i := range values {
+			if name, ok := names[r.id][values[i].value]; ok {
+				values[i].name = name
+				values[i].identifier = identifier(name)
+			}
+		}
+		sort.Slice(values, func(i, j int) bool { return values[i].value < values[j].value })
+		seen := make(map[string]bool)
+		for i := range values {
+			values[i].identifier = r.prefix + values[i].identifier
+			if seen[values[i].identifier] {
+				log.Panicln("Duplicate identifier", values[i].identifier)
+			}
+			seen[values[i].identifier] = true
+		}
+		generateRegistry(g, r, ie, values)
+		elements = append(elements, fmt.Sprintf("%d: %sNames,\n", ie.ID, lowerFirst(r.typeName)))
+	}
+	fmt.Fprintf(g, "\n// valueNames holds the names of the values of iana information elements with a value registry\n")
+	fmt.Fprintf(g, "var valueNames = map[uint16]map[uint64]string{\n%s}\n", strings.Join(elements, ""))
+
+	code, err := format.Source(g.Bytes())
+	if err != nil {
+		log.Panicln("Generated invalid code", err)
+	}
+	if err := ioutil.WriteFile(os.Args[2], code, 0666); err != nil {
+		log.Panicln("Couldn't write output file", os.Args[2], err)
+	}
+}
+
+func findRegistry(r xmlRegistry, id string) *xmlRegistry {
+	if r.ID == id {
+		return &r
+	}
+	for _, sub := range r.Registries {
+		if found := findRegistry(sub, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// collectValues returns the assigned values of r and its sub-registries. Ranges, unassigned, and
+// reserved values are skipped.
+func collectValues(r xmlRegistry, prefix string) []value {
+	var values []value
+	for _, rec := range r.Records {
+		v, err := strconv.ParseUint(strings.TrimSpace(rec.Value), 0, 64)
+		if err != nil {
+			// ranges and the binary status codes of forwarding-status
+			continue
+		}
+		name := strings.Join(strings.Fields(rec.Description), " ")
+		if name == "" || strings.HasPrefix(name, "Unassigned") || strings.HasPrefix(name, "Reserved") {
+			continue
+		}
+		// long descriptions start with a short name, e.g., "TE-MIDPT: Any TE tunnel ..."
+		if colon := strings.Index(name, ": "); colon >= 0 {
+			name = name[:colon]
+		}
+		name = strings.TrimSuffix(name, ".")
+		if prefix != "" {
+			name = prefix + ": " + name
+		}
+		values = append(values, value{v, name, identifier(name)})
+	}
+	for _, sub := range r.Registries {
+		// sub-registries are titled like "Status 01b: Forwarded"
+		title := sub.Title
+		if colon := strings.Index(title, ": "); colon >= 0 {
+			title = title[colon+2:]
+		}
+		values = append(values, collectValues(sub, title)...)
+	}
+	return values
+}
+
+// identifier returns the go identifier for name, where words are capitalized, acronyms are kept, and
+// parenthesized remarks like (Historic) are dropped.
+func identifier(name string) string {
+	if paren := strings.Index(name, " ("); paren >= 0 {
+		name = name[:paren]
+	}
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var ret strings.Builder
+	for _, word := range words {
+		r, n := utf8.DecodeRuneInString(word)
+		ret.WriteRune(unicode.ToUpper(r))
+		ret.WriteString(word[n:])
+	}
+	return ret.String()
+}
+
+func lowerFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[n:]
+}
+
+func generateRegistry(g *bytes.Buffer, r registry, ie ipfix.InformationElement, values []value) {
+	var goType string
+	switch ie.Type {
+	case ipfix.Unsigned8Type:
+		goType = "uint8"
+	case ipfix.Unsigned16Type:
+		goType = "uint16"
+	case ipfix.Unsigned32Type:
+		goType = "uint32"
+	case ipfix.Unsigned64Type:
+		goType = "uint64"
+	default:
+		log.Panicf("Information element %s has unsupported type %s\n", ie.Name, ie.Type)
+	}
+	names := lowerFirst(r.typeName) + "Names"
+	fmt.Fprintf(g, "\n// %s holds the values of the information element %s as assigned by the iana.\n", r.typeName, ie.Name)
+	fmt.Fprintf(g, "type %s %s\n\n", r.typeName, goType)
+	fmt.Fprintf(g, "const (\n")
+	for _, v := range values {
+		fmt.Fprintf(g, "// %s is %q\n", v.identifier, v.name)
+		fmt.Fprintf(g, "%s %s = %d\n", v.identifier, r.typeName, v.value)
+	}
+	fmt.Fprintf(g, ")\n\n")
+	fmt.Fprintf(g, "var %s = map[uint64]string{\n", names)
+	for _, v := range values {
+		fmt.Fprintf(g, "%d: %q,\n", v.value, v.name)
+	}
+	fmt.Fprintf(g, "}\n\n")
+	fmt.Fprintf(g, "func (v %s) String() string {\n", r.typeName)
+	fmt.Fprintf(g, "if name, ok := %s[uint64(v)]; ok {\nreturn name\n}\n", names)
+	fmt.Fprintf(g, "return \"%s(\" + strconv.FormatUint(uint64(v), 10) + \")\"\n}\n", r.typeName)
+}
@@ -4,14 +4,12 @@ package main
 
 import (
 	"bufio"
-	"bytes"
-	"encoding/xml"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
 	"reflect"
-	"strings"
 	"unicode"
 	"unicode/utf8"
 
@@ -60,24 +58,41 @@ func main() {
 
 // GENERATED BY generate_spec; DO NOT CHANGE!
 
-var loaded%s = false
+import "sync"
 
-// %s loads information elements from the given specification
+var once%s sync.Once
+
+// %s loads information elements from the given specification into the DefaultRegistry.
+// It is safe to call %s several times and concurrently.
 func %s() {
-	if loaded%s {
-		return
-	}
-	loaded%s = true
-`, funcName, funcName, funcName, funcName, funcName)
+	once%s.Do(func() {
+		%sInto(DefaultRegistry)
+	})
+}
+
+// %sInto registers the information elements from the given specification in r
+func %sInto(r *Registry) {
+`, funcName, funcName, funcName, funcName, funcName, funcName, funcName, funcName)
 	cb := func(ie ipfix.InformationElement) {
 		iev := reflect.ValueOf(ie)
 		iet := reflect.TypeOf(ie)
-		wr.WriteString("	RegisterInformationElement(InformationElement{")
+		wr.WriteString("	r.Register(InformationElement{")
 		for i := 0; i < iev.NumField()-1; i++ {
+			if i > 4 && iev.Field(i).IsZero() {
+				// unspecified metadata after Length is omitted
+				continue
+			}
 			if i > 0 {
 				wr.WriteString(", ")
 			}
-			fmt.Fprintf(wr, "%s: %#v", iet.Field(i).Name, iev.Field(i))
+			switch v := iev.Field(i).Interface().(type) {
+			case ipfix.DataTypeSemantics:
+				fmt.Fprintf(wr, "%s: %sSemantics", iet.Field(i).Name, upperFirst(v.String()))
+			case ipfix.Status:
+				fmt.Fprintf(wr, "%s: %sStatus", iet.Field(i).Name, upperFirst(v.String()))
+			default:
+				fmt.Fprintf(wr, "%s: %#v", iet.Field(i).Name, iev.Field(i))
+			}
 		}
 		wr.WriteString("})\n")
 	}
@@ -95,81 +110,30 @@ func %s() {
 }
 
 func iespec(spec *os.File, cb func(ipfix.InformationElement)) {
-	rd := bufio.NewScanner(spec)
-	for rd.Scan() {
-		if ie, err := ipfix.MakeIEFromSpec(rd.Bytes()); err == nil {
-			cb(ie)
-		} else {
-			log.Panic(err)
-		}
+	b, err := ioutil.ReadAll(spec)
+	if err != nil {
+		log.Panic(err)
+	}
+	elements, err := ipfix.ParseIESpecs(b)
+	if err != nil {
+		log.Panic(err)
+	}
+	for _, ie := range elements {
+		cb(ie)
 	}
 }
 
 func xmlspec(spec *os.File, cb func(ipfix.InformationElement)) {
-	type Record struct {
-		XMLName   xml.Name `xml:"record"`
-		Name      string   `xml:"name"`
-		DataType  []byte   `xml:"dataType"`
-		ElementID int      `xml:"elementId"`
-	}
-	dec := xml.NewDecoder(spec)
-SEARCH_IES:
-	for {
-		if tok, err := dec.Token(); err != nil {
-			log.Panic(err)
-		} else {
-			if tok, ok := tok.(xml.StartElement); ok {
-				if tok.Name.Local == "registry" && len(tok.Attr) == 1 && tok.Attr[0].Name.Local == "id" && tok.Attr[0].Value == "ipfix-information-elements" {
-					break SEARCH_IES
-				}
-			}
-		}
-	}
-	var start xml.StartElement
-SEARCH_FIRST_RECORD:
-	for {
-		if tok, err := dec.Token(); err != nil {
-			log.Panic(err)
-		} else {
-			if tok, ok := tok.(xml.StartElement); ok {
-				if tok.Name.Local == "record" {
-					start = tok
-					break SEARCH_FIRST_RECORD
-				}
-			}
-		}
+	elements, err := ipfix.ParseIANAXML(spec)
+	if err != nil {
+		log.Panic(err)
 	}
-NEXT_RECORD:
-	for {
-		var rec Record
-		dec.DecodeElement(&rec, &start)
-		rec.Name = strings.TrimSpace(rec.Name)
-		rec.DataType = bytes.TrimSpace(rec.DataType)
-		if rec.Name != "" && rec.ElementID != 0 && len(rec.DataType) != 0 &&
-			string(rec.DataType) != "basicList" && string(rec.DataType) != "subTemplateList" && string(rec.DataType) != "subTemplateMultiList" {
-			//SMELL: hardcoded iana stuff
-			cb(ipfix.NewInformationElement(rec.Name, 0, uint16(rec.ElementID), ipfix.NameToType(rec.DataType), 0))
-		}
-		for {
-			if tok, err := dec.Token(); err != nil {
-				log.Panic(err)
-			} else {
-				switch t := tok.(type) {
-				case xml.StartElement:
-					if t.Name.Local == "record" {
-						start = t
-						continue NEXT_RECORD
-					}
-					if t.Name.Local == "registry" {
-						goto FINISHED
-					}
-				case xml.EndElement:
-					if t.Name.Local == "registry" {
-						goto FINISHED
-					}
-				}
-			}
-		}
+	for _, ie := range elements {
+		cb(ie)
 	}
-FINISHED:
+}
+
+func upperFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
 }
@@ -1,3 +1,3 @@
 module github.com/CN-TU/go-ipfix
 
-go 1.12
+go 1.18
@@ -3,7 +3,6 @@ package ipfix
 import (
 	"encoding/binary"
 	"fmt"
-	"reflect"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -12,6 +11,12 @@ import (
 // basicListID is the IE ID of basicLists as defined by RFC6313
 const basicListID = 291
 
+// subTemplateListID is the IE ID of subTemplateLists as defined by RFC6313
+const subTemplateListID = 292
+
+// subTemplateMultiListID is the IE ID of subTemplateMultiLists as defined by RFC6313
+const subTemplateMultiListID = 293
+
 const ianaPen = 0
 const reversePen = 29305
 
@@ -29,8 +34,56 @@ type InformationElement struct {
 	// Type is the associated data type
 	Type Type
 	// Length is the length of the field value
-	Length  uint16
-	subType subType
+	Length uint16
+	// Semantics are the data type semantics of the values
+	Semantics DataTypeSemantics
+	// Units of the values, e.g., octets; empty if not specified
+	Units string
+	// Range of valid values, e.g., 0-255; empty if not specified
+	Range string
+	// Status of the definition in the registry
+	Status Status
+	// Description of the information element; paragraphs are separated by empty lines
+	Description string
+	subType     subType
+}
+
+// Status is the status of an information element definition in the iana registry according to RFC7013
+type Status byte
+
+const (
+	// CurrentStatus is a valid definition and the default
+	CurrentStatus Status = iota
+	// DeprecatedStatus is a definition that should no longer be used for new implementations
+	DeprecatedStatus
+	// ObsoleteStatus is a definition that must no longer be used
+	ObsoleteStatus
+)
+
+func (s Status) String() string {
+	switch s {
+	case CurrentStatus:
+		return "current"
+	case DeprecatedStatus:
+		return "deprecated"
+	case ObsoleteStatus:
+		return "obsolete"
+	}
+	return "unassigned"
+}
+
+// NameToStatus converts the given textual representation of a status. The second return value is false
+// if the status is not recognised.
+func NameToStatus(x []byte) (Status, bool) {
+	switch string(x) {
+	case "current":
+		return CurrentStatus, true
+	case "deprecated":
+		return DeprecatedStatus, true
+	case "obsolete":
+		return ObsoleteStatus, true
+	}
+	return CurrentStatus, false
 }
 
 // NewInformationElement returns an information element for the given specification. If length is 0,
@@ -39,18 +92,38 @@ func NewInformationElement(name string, pen uint32, id uint16, t Type, length ui
 	if t != IllegalType && length == 0 {
 		length = DefaultSize[t]
 	}
-	return InformationElement{name, pen, id, t, length, nil}
+	return InformationElement{Name: name, Pen: pen, ID: id, Type: t, Length: length}
+}
+
+// basicList holds the list element and semantic of basicLists
+type basicList struct {
+	element  InformationElement
+	semantic StructuredSemantic
 }
 
 // NewBasicList returns an InformationElement holding the basic list according to RFC6313. If number is 0,
-// a variable length list is returned.
+// a variable length list is returned. The semantic of the list is UndefinedSemantic.
 func NewBasicList(name string, subelement InformationElement, number uint16) InformationElement {
+	return NewBasicListWithSemantic(name, subelement, number, UndefinedSemantic)
+}
+
+// NewBasicListWithSemantic returns an InformationElement holding the basic list with the given semantic
+// according to RFC6313. If number is 0, a variable length list is returned. The semantic can be
+// overridden for single values with SemanticList.
+func NewBasicListWithSemantic(name string, subelement InformationElement, number uint16, semantic StructuredSemantic) InformationElement {
 	// RFC6313: semantic + template of element + number of elements * size of element
 	length := 1 + uint16(subelement.templateSize()) + number*subelement.Length
 	if number == 0 || subelement.Length == VariableLength || number == VariableLength {
 		length = VariableLength
 	}
-	return InformationElement{name, ianaPen, basicListID, BasicListType, length, subelement}
+	return InformationElement{
+		Name:    name,
+		Pen:     ianaPen,
+		ID:      basicListID,
+		Type:    BasicListType,
+		Length:  length,
+		subType: basicList{subelement, semantic},
+	}
 }
 
 // Reverse returns the reverse information element according to RFC5103
@@ -59,45 +132,57 @@ func (ie InformationElement) Reverse() InformationElement {
 		// this is a reverse Element
 		name := strings.TrimPrefix(ie.Name, "reverse")
 		first, len := utf8.DecodeRuneInString(name)
-		name = string(unicode.ToLower(first)) + string(name[len:])
-		return InformationElement{
-			Name:    name,
-			Pen:     ianaPen,
-			ID:      ie.ID,
-			Type:    ie.Type,
-			Length:  ie.Length,
-			subType: ie.subType,
-		}
+		ie.Name = string(unicode.ToLower(first)) + string(name[len:])
+		ie.Pen = ianaPen
+		return ie
 	}
 	if ie.Pen == ianaPen {
 		// this is a non-reverse element
 		name := ie.Name
 		first, len := utf8.DecodeRuneInString(name)
-		name = "reverse" + string(unicode.ToUpper(first)) + string(name[len:])
-		return InformationElement{
-			Name:    name,
-			Pen:     reversePen,
-			ID:      ie.ID,
-			Type:    ie.Type,
-			Length:  ie.Length,
-			subType: ie.subType,
-		}
+		ie.Name = "reverse" + string(unicode.ToUpper(first)) + string(name[len:])
+		ie.Pen = reversePen
+		return ie
 	}
 	panic("This element has no reverse")
 }
 
+// String returns the iespec of this information element as parsed by ParseIESpecs. Only the fields of ie
+// are used: iana information elements are referenced by name, which requires them to be registered for
+// parsing, and all others are written with enterprise number, id, type, semantics, units, and range.
+// Status and Description are not part of iespecs.
 func (ie InformationElement) String() string {
-	if ie.Type == BasicListType {
-		return fmt.Sprintf("basicList{allOf}\n+%s", ie.subType)
+	list, isList := ie.subType.(basicList)
+	var ret string
+	if ie.Pen == ianaPen && ie.Name != "" && ie.ID != 0 {
+		ret = ie.Name
+		if isList {
+			ret += "{" + list.semantic.String() + "}"
+		}
+	} else {
+		// Output information element spec according to RFC7013 Section 10.1
+		ret = fmt.Sprintf("%s(%d/%d)<%s>", ie.Name, ie.Pen, ie.ID, ie.Type)
+		annotations := []string{"", ie.Units, ie.Range}
+		if isList {
+			annotations[0] = list.semantic.String()
+		} else if ie.Semantics != DefaultSemantics {
+			annotations[0] = ie.Semantics.String()
+		}
+		for len(annotations) > 0 && annotations[len(annotations)-1] == "" {
+			annotations = annotations[:len(annotations)-1]
+		}
+		if len(annotations) > 0 {
+			ret += "{" + strings.Join(annotations, ",") + "}"
+		}
 	}
-	if ie.Pen == 0 && ie.Name != "" && ie.ID != 0 {
-		return ie.Name
+	if ie.Type == IllegalType || ie.Length != DefaultSize[ie.Type] {
+		ret += "[" + formatLength(ie.Length) + "]"
 	}
-	// Output information element spec according to RFC7013 Section 10.1
-	if (ie.Length == 0 && ie.Type != IllegalType) || ie.Length == DefaultSize[ie.Type] {
-		return fmt.Sprintf("%s(%d/%d)<%s>", ie.Name, ie.Pen, ie.ID, ie.Type)
+	if isList {
+		// the list element follows in the next line
+		ret += "\n+" + strings.Replace(list.element.String(), "\n", "\n+", -1)
 	}
-	return fmt.Sprintf("%s(%d/%d)<%s>[%d]", ie.Name, ie.Pen, ie.ID, ie.Type, ie.Length)
+	return ret
 }
 
 func (ie InformationElement) templateSize() int {
@@ -132,16 +217,27 @@ func (ie InformationElement) serializeTo(buffer scratchBuffer) (int, error) {
 // ListElement returns the InformationElement of a list item and true if this InformationElement is a list.
 // Otherwise an empty InformationElement and false is returned.
 func (ie InformationElement) ListElement() (InformationElement, bool) {
-	if ie.Type != BasicListType {
+	list, ok := ie.subType.(basicList)
+	if !ok {
 		return InformationElement{}, false
 	}
-	return ie.subType.(InformationElement), true
+	return list.element, true
 }
 
 func (ie InformationElement) serializeDataTo(buffer scratchBuffer, value interface{}) error {
 	switch ie.Type {
+	case SubTemplateListType:
+		return ie.serializeSubTemplateListTo(buffer, value)
+	case SubTemplateMultiListType:
+		return ie.serializeSubTemplateMultiListTo(buffer, value)
 	case BasicListType:
-		subie, _ := ie.ListElement()
+		list, ok := ie.subType.(basicList)
+		if !ok {
+			return ConversionError{ie.Type, value}
+		}
+		subie := list.element
+		var semantic StructuredSemantic
+		semantic, value = listSemantic(value, list.semantic)
 		// Header according to RFC6313
 		written := 1
 
@@ -163,7 +259,7 @@ func (ie InformationElement) serializeDataTo(buffer scratchBuffer, value interfa
 		if err != nil {
 			return err
 		}
-		b[0] = byte(UndefinedSemantic)
+		b[0] = byte(semantic)
 		// followed by template header
 		headersize, err := subie.serializeTo(buffer)
 		if err != nil {
@@ -171,20 +267,11 @@ func (ie InformationElement) serializeDataTo(buffer scratchBuffer, value interfa
 		}
 		written += headersize
 		// followed by all the values
-		if value != nil {
-			values := reflect.ValueOf(value)
-			for values.Kind() == reflect.Ptr {
-				values = values.Elem()
-			}
-			l := values.Len()
-			for i := 0; i < l; i++ {
-				subiesize, err := subie.Type.serializeDataTo(buffer, values.Index(i).Interface(), int(subie.Length))
-				if err != nil {
-					return err
-				}
-				written += subiesize
-			}
+		valuesize, err := serializeListValuesTo(buffer, subie, value)
+		if err != nil {
+			return subie.elementError(err)
 		}
+		written += valuesize
 		if ie.Length == VariableLength {
 			binary.BigEndian.PutUint16(lengthbuffer, uint16(written))
 		} else {
@@ -193,7 +280,17 @@ func (ie InformationElement) serializeDataTo(buffer scratchBuffer, value interfa
 			}
 		}
 	default:
-		ie.Type.serializeDataTo(buffer, value, int(ie.Length))
+		_, err := ie.Type.serializeDataTo(buffer, value, int(ie.Length))
+		return ie.elementError(err)
 	}
 	return nil
 }
+
+// elementError adds this information element to range errors.
+func (ie InformationElement) elementError(err error) error {
+	if rerr, ok := err.(RangeError); ok {
+		rerr.ie = ie
+		return rerr
+	}
+	return err
+}
@@ -23,6 +23,53 @@ func ExampleInformationElement_Reverse() {
 	fmt.Println(revrevie)
 	// Output:
 	// octetDeltaCount
-	// reverseOctetDeltaCount(29305/1)<unsigned64>
+	// reverseOctetDeltaCount(29305/1)<unsigned64>{deltaCounter,octets}
 	// octetDeltaCount
 }
+
+func ExampleInformationElement_metadata() {
+	ipfix.LoadIANASpec()
+
+	for _, name := range []string{"octetDeltaCount", "flowLabelIPv6", "samplingInterval"} {
+		ie, err := ipfix.GetInformationElement(name)
+		if err != nil {
+			fmt.Println("GetInformationElement failed:", err)
+			return
+		}
+		fmt.Printf("%s: semantics=%s units=%q range=%q status=%s\n", ie.Name, ie.Semantics, ie.Units, ie.Range, ie.Status)
+	}
+	// Output:
+	// octetDeltaCount: semantics=deltaCounter units="octets" range="" status=current
+	// flowLabelIPv6: semantics=identifier units="" range="0-0xFFFFF" status=current
+	// samplingInterval: semantics=quantity units="packets" range="" status=deprecated
+}
+
+func ExampleGetInformationElementByID() {
+	ipfix.LoadIANASpec()
+
+	for _, id := range []struct {
+		pen uint32
+		id  uint16
+	}{{0, 1}, {29305, 8}, {0, 291}, {12345, 1}} {
+		ie, ok := ipfix.GetInformationElementByID(id.pen, id.id)
+		fmt.Println(ie, ok)
+	}
+	// Output:
+	// octetDeltaCount true
+	// reverseSourceIPv4Address(29305/8)<ipv4Address> true
+	// basicList true
+	// (12345/1)<octetArray> false
+}
+
+func ExampleInformationElements() {
+	ipfix.LoadIANASpec()
+
+	elements := ipfix.InformationElements()
+	for _, ie := range elements[:3] {
+		fmt.Println(ie.ID, ie.Name)
+	}
+	// Output:
+	// 1 octetDeltaCount
+	// 2 packetDeltaCount
+	// 3 deltaFlowCount
+}
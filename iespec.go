@@ -1,69 +1,359 @@
 package ipfix
 
 import (
+	"bytes"
 	"fmt"
 	"regexp"
 	"strconv"
 )
 
-// iespec according to RFC7013 section 10.1
-var iespecRegex = regexp.MustCompile(`(?m)^(.+)\(((\d+)/)?(\d+)\)<(.+)>(\[(.+)\])?$`)
-
 // MakeIEFromSpec returns an InformationElement as specified by the provided specification.
-// The specification format must follow RFC7013 section 10.1
+// The specification format must follow RFC7013 section 10.1 as described at ParseIESpecs and hold a single
+// information element, which can be a basicList followed by its list element.
+// Names are looked up in the DefaultRegistry.
 func MakeIEFromSpec(spec []byte) (InformationElement, error) {
-	var err error
-	x := iespecRegex.FindSubmatch(spec)
-	if x == nil {
-		return InformationElement{}, fmt.Errorf("ipfix: Could not parse iespec '%s'", spec)
+	return DefaultRegistry.MakeIEFromSpec(spec)
+}
+
+// MakeIEFromSpec returns an InformationElement like the package function MakeIEFromSpec, but looks up
+// names in r.
+func (r *Registry) MakeIEFromSpec(spec []byte) (InformationElement, error) {
+	elements, err := r.ParseIESpecs(spec)
+	if err != nil {
+		return InformationElement{}, err
 	}
-	name := string(x[1])
-	pen := 0
-	if x[3] != nil {
-		if pen, err = strconv.Atoi(string(x[3])); err != nil {
-			return InformationElement{}, fmt.Errorf("ipfix: Could not parse pen '%s'. Must be valid number", x[3])
-		}
+	if len(elements) != 1 {
+		return InformationElement{}, IESpecError{1, 1, fmt.Sprintf("expected one information element, got %d", len(elements))}
 	}
-	var id int
-	if id, err = strconv.Atoi(string(x[4])); err != nil {
-		return InformationElement{}, fmt.Errorf("ipfix: Could not parse id '%s'. Must be valid number", x[4])
+	return elements[0], nil
+}
+
+// ParseIESpecs returns the information elements of an iespec file, which holds one iespec according to
+// RFC7013 section 10.1 per line:
+//
+//	name(pen/id)<type>{semantics,units,range}[length]
+//
+// The pen can be omitted for iana information elements. semantics, units, and range are optional, as
+// is every part in the curly braces; e.g., {identifier} or {totalCounter,octets}. The length is either a
+// number or v for variable length; without a length the default length of the type is used. Registered
+// information elements can be referenced by name only, optionally followed by the curly braces and the
+// length, e.g., octetDeltaCount[4].
+//
+// The list element of a basicList follows the basicList in the next line prefixed with a +, while the
+// first part of the curly braces of a basicList holds the list semantic, e.g.:
+//
+//	basicList{allOf}
+//	+octetDeltaCount
+//
+// Everything after a # is a comment; empty lines are ignored. This is the format of
+// InformationElement.String. Syntax errors are returned as IESpecError. Names are looked up in the
+// DefaultRegistry.
+func ParseIESpecs(spec []byte) ([]InformationElement, error) {
+	return DefaultRegistry.ParseIESpecs(spec)
+}
+
+// ParseIESpecs parses information elements like the package function ParseIESpecs, but looks up names
+// in r.
+func (r *Registry) ParseIESpecs(spec []byte) ([]InformationElement, error) {
+	type node struct {
+		ie       InformationElement
+		depth    int
+		semantic StructuredSemantic
+		element  int
 	}
-	t := NameToType(x[5])
-	if t == IllegalType {
-		return InformationElement{}, fmt.Errorf("ipfix: Could not parse id '%s'. Must be valid ipfix type", x[4])
+	var nodes []node
+	// parents holds the last node for every depth
+	var parents []int
+	for i, line := range bytes.Split(spec, []byte("\n")) {
+		if comment := bytes.IndexByte(line, '#'); comment >= 0 {
+			line = line[:comment]
+		}
+		p := iespecParser{line: bytes.TrimRight(line, " \t\r"), number: i + 1, registry: r}
+		p.skipSpace()
+		if p.pos == len(p.line) {
+			continue
+		}
+		depth := 0
+		for p.peek() == '+' {
+			depth++
+			p.pos++
+		}
+		p.skipSpace()
+		start := p.pos
+		ie, semantic, err := p.parseElement()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.pos != len(p.line) {
+			return nil, p.errorf(p.pos, "unexpected %q", p.line[p.pos:])
+		}
+		if depth > len(parents) {
+			return nil, p.errorf(start, "list element without basicList")
+		}
+		parents = parents[:depth]
+		if depth > 0 {
+			parent := &nodes[parents[depth-1]]
+			if parent.ie.Type != BasicListType {
+				return nil, p.errorf(start, "list element for %s, which is not a basicList", parent.ie.Type)
+			}
+			if parent.element >= 0 {
+				return nil, p.errorf(start, "second list element for basicList")
+			}
+			parent.element = len(nodes)
+		}
+		parents = append(parents, len(nodes))
+		nodes = append(nodes, node{ie, depth, semantic, -1})
+	}
+	var build func(i int) InformationElement
+	build = func(i int) InformationElement {
+		n := nodes[i]
+		if n.element >= 0 {
+			n.ie.subType = basicList{build(n.element), n.semantic}
+		}
+		return n.ie
 	}
-	length := 0
-	if x[7] != nil && x[7][0] != 'v' {
-		if length, err = strconv.Atoi(string(x[7])); err != nil {
-			return InformationElement{}, fmt.Errorf("ipfix: Could not length '%s'. Must be valid number", x[4])
+	var elements []InformationElement
+	for i, n := range nodes {
+		if n.depth == 0 {
+			elements = append(elements, build(i))
 		}
 	}
-	if length == 0 {
-		length = int(DefaultSize[t])
+	return elements, nil
+}
+
+// iespecRange matches the range of valid values, e.g., 0-255 or 0-0xFFFF
+var iespecRange = regexp.MustCompile(`^-?(\d+|0[xX][[:xdigit:]]+)-(\d+|0[xX][[:xdigit:]]+)$`)
+
+// iespecParser parses a single line of an iespec file
+type iespecParser struct {
+	line     []byte
+	pos      int
+	number   int
+	registry *Registry
+}
+
+func (p *iespecParser) errorf(pos int, format string, args ...interface{}) error {
+	return IESpecError{p.number, pos + 1, fmt.Sprintf(format, args...)}
+}
+
+func (p *iespecParser) peek() byte {
+	if p.pos < len(p.line) {
+		return p.line[p.pos]
 	}
-	return NewInformationElement(name, uint32(pen), uint16(id), t, uint16(length)), nil
+	return 0
 }
 
-var informationElementRegistry map[string]InformationElement
+func (p *iespecParser) skipSpace() {
+	for p.peek() == ' ' || p.peek() == '\t' {
+		p.pos++
+	}
+}
 
-func init() {
-	informationElementRegistry = make(map[string]InformationElement)
+// until returns everything up to the first of the given characters or the end of the line
+func (p *iespecParser) until(chars string) []byte {
+	start := p.pos
+	if end := bytes.IndexAny(p.line[start:], chars); end >= 0 {
+		p.pos += end
+	} else {
+		p.pos = len(p.line)
+	}
+	return p.line[start:p.pos]
 }
 
-// RegisterInformationElement registers the given InformationElement. This can later be queried by name with GetInformationElement.
-func RegisterInformationElement(x InformationElement) error {
-	if _, ok := informationElementRegistry[x.Name]; ok {
-		return fmt.Errorf("ipfix: Information element with name '%s' already registered", x.Name)
+func (p *iespecParser) expect(c byte) error {
+	if p.peek() != c {
+		if p.pos == len(p.line) {
+			return p.errorf(p.pos, "expected '%c' at end of line", c)
+		}
+		return p.errorf(p.pos, "expected '%c', got '%c'", c, p.peek())
 	}
-	informationElementRegistry[x.Name] = x
+	p.pos++
 	return nil
 }
 
-// GetInformationElement retrieves an InformationElement by name.
-func GetInformationElement(name string) (ret InformationElement, err error) {
-	var ok bool
-	if ret, ok = informationElementRegistry[name]; !ok {
-		err = fmt.Errorf("ipfix: No information element with name '%s' registered", name)
+// parseNumber parses a decimal number up to the given bit size
+func (p *iespecParser) parseNumber(what string, bits int) (uint64, error) {
+	start := p.pos
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, p.errorf(start, "expected %s", what)
+	}
+	n, err := strconv.ParseUint(string(p.line[start:p.pos]), 10, bits)
+	if err != nil {
+		return 0, p.errorf(start, "%s %s out of range", what, p.line[start:p.pos])
+	}
+	return n, nil
+}
+
+// parseElement parses a single iespec or a reference to a registered information element. The list
+// semantic is only used by basicLists and is UndefinedSemantic if not specified.
+func (p *iespecParser) parseElement() (ie InformationElement, semantic StructuredSemantic, err error) {
+	semantic = UndefinedSemantic
+	start := p.pos
+	name := string(p.until("(<{[ \t"))
+	if p.peek() == '(' {
+		p.pos++
+		var pen, id uint64
+		numberStart := p.pos
+		if id, err = p.parseNumber("pen or id", 32); err != nil {
+			return
+		}
+		if p.peek() == '/' {
+			p.pos++
+			pen = id
+			if id, err = p.parseNumber("id", 16); err != nil {
+				return
+			}
+		} else if id > 0xffff {
+			return ie, semantic, p.errorf(numberStart, "id %d out of range", id)
+		}
+		if err = p.expect(')'); err != nil {
+			return
+		}
+		if err = p.expect('<'); err != nil {
+			return
+		}
+		typeStart := p.pos
+		typeName := p.until(">")
+		t := NameToType(typeName)
+		if t == IllegalType {
+			return ie, semantic, p.errorf(typeStart, "unknown type %q", typeName)
+		}
+		if err = p.expect('>'); err != nil {
+			return
+		}
+		ie = NewInformationElement(name, uint32(pen), uint16(id), t, 0)
+	} else {
+		if name == "" {
+			return ie, semantic, p.errorf(start, "missing information element")
+		}
+		var ok bool
+		if ie, ok = p.registry.registeredElement(name); !ok {
+			return ie, semantic, p.errorf(start, "unknown information element %q", name)
+		}
+	}
+	if p.peek() == '{' {
+		if semantic, err = p.parseAnnotations(&ie); err != nil {
+			return
+		}
+	}
+	if p.peek() == '[' {
+		err = p.parseLength(&ie)
 	}
 	return
 }
+
+// registeredElement returns the registered information element with the given name. The structured
+// data types from RFC6313 are known even if the iana information elements are not loaded.
+func (r *Registry) registeredElement(name string) (InformationElement, bool) {
+	if ie, err := r.Get(name); err == nil {
+		return ie, true
+	}
+	switch name {
+	case "basicList":
+		return NewInformationElement(name, ianaPen, basicListID, BasicListType, 0), true
+	case "subTemplateList":
+		return NewInformationElement(name, ianaPen, subTemplateListID, SubTemplateListType, 0), true
+	case "subTemplateMultiList":
+		return NewInformationElement(name, ianaPen, subTemplateMultiListID, SubTemplateMultiListType, 0), true
+	}
+	return InformationElement{}, false
+}
+
+// parseAnnotations parses {semantics,units,range} into ie. basicLists take the list semantic instead
+// of the data type semantics.
+func (p *iespecParser) parseAnnotations(ie *InformationElement) (semantic StructuredSemantic, err error) {
+	semantic = UndefinedSemantic
+	open := p.pos
+	p.pos++
+	end := bytes.IndexByte(p.line[p.pos:], '}')
+	if end < 0 {
+		return semantic, p.errorf(open, "missing '}'")
+	}
+	end += p.pos
+	for i, field := range bytes.Split(p.line[p.pos:end], []byte(",")) {
+		start := p.pos
+		p.pos += len(field) + 1
+		trimmed := bytes.TrimLeft(field, " \t")
+		start += len(field) - len(trimmed)
+		trimmed = bytes.TrimRight(trimmed, " \t")
+		if len(trimmed) == 0 {
+			continue
+		}
+		switch i {
+		case 0:
+			if ie.Type == BasicListType {
+				var ok bool
+				if semantic, ok = nameToStructuredSemantic(trimmed); ok {
+					continue
+				}
+			}
+			s, ok := NameToSemantics(trimmed)
+			if !ok {
+				return semantic, p.errorf(start, "unknown semantics %q", trimmed)
+			}
+			ie.Semantics = s
+		case 1:
+			ie.Units = string(trimmed)
+		case 2:
+			if !iespecRange.Match(trimmed) {
+				return semantic, p.errorf(start, "invalid range %q", trimmed)
+			}
+			ie.Range = string(trimmed)
+		default:
+			return semantic, p.errorf(start, "unexpected %q; expected only semantics, units, and range", trimmed)
+		}
+	}
+	p.pos = end + 1
+	return semantic, nil
+}
+
+// parseLength parses [length] into ie
+func (p *iespecParser) parseLength(ie *InformationElement) error {
+	open := p.pos
+	p.pos++
+	length := VariableLength
+	if p.peek() == 'v' {
+		p.pos++
+	} else {
+		n, err := p.parseNumber("length", 16)
+		if err != nil {
+			return err
+		}
+		length = uint16(n)
+	}
+	if err := p.expect(']'); err != nil {
+		return err
+	}
+	if !validLength(ie.Type, length) {
+		return p.errorf(open, "invalid length %s for type %s", formatLength(length), ie.Type)
+	}
+	ie.Length = length
+	return nil
+}
+
+// validLength returns true if values of type t can be encoded with the given length
+func validLength(t Type, length uint16) bool {
+	switch t {
+	case OctetArrayType, StringType, BasicListType, SubTemplateListType, SubTemplateMultiListType:
+		return true
+	case MacAddressType, Ipv4AddressType, Ipv6AddressType:
+		return length == DefaultSize[t] || length == VariableLength
+	case Float64Type:
+		return length == 4 || length == 8
+	case Unsigned8Type, Unsigned16Type, Unsigned32Type, Unsigned64Type, Signed8Type, Signed16Type, Signed32Type, Signed64Type, Unsigned256Type:
+		return length >= 1 && length <= DefaultSize[t]
+	}
+	return length == DefaultSize[t]
+}
+
+// formatLength returns the length as used by iespecs
+func formatLength(length uint16) string {
+	if length == VariableLength {
+		return "v"
+	}
+	return strconv.Itoa(int(length))
+}
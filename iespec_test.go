@@ -0,0 +1,121 @@
+package ipfix_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+func ExampleParseIESpecs() {
+	elements, err := ipfix.ParseIESpecs([]byte(`
+# flow counters
+octets(35566/1)<unsigned64>{deltaCounter,octets}
+packets(35566/2)<unsigned64>{totalCounter, packets}[4] # reduced-size
+ttl(35566/3)<unsigned8>{quantity,hops,1-255}
+name(35566/4)<string>[v]
+ports(35566/5)<basicList>{allOf}
++port(35566/6)<unsigned16>{identifier}
+`))
+	if err != nil {
+		fmt.Println("ParseIESpecs failed:", err)
+		return
+	}
+	for _, ie := range elements {
+		fmt.Println(ie)
+	}
+	// Output:
+	// octets(35566/1)<unsigned64>{deltaCounter,octets}
+	// packets(35566/2)<unsigned64>{totalCounter,packets}[4]
+	// ttl(35566/3)<unsigned8>{quantity,hops,1-255}
+	// name(35566/4)<string>
+	// ports(35566/5)<basicList>{allOf}
+	// +port(35566/6)<unsigned16>{identifier}
+}
+
+func TestIESpecRoundTrip(t *testing.T) {
+	ipfix.LoadIANASpec()
+	octetDeltaCount, _ := ipfix.GetInformationElement("octetDeltaCount")
+	reduced := octetDeltaCount
+	reduced.Length = 4
+	address := ipfix.NewInformationElement("address", 35566, 1, ipfix.Ipv4AddressType, ipfix.VariableLength)
+	annotated := ipfix.NewInformationElement("annotated", 35566, 2, ipfix.Signed32Type, 2)
+	annotated.Semantics = ipfix.QuantitySemantics
+	annotated.Range = "-100-100"
+	units := ipfix.NewInformationElement("units", 35566, 5, ipfix.Float64Type, 4)
+	units.Units = "seconds"
+	// iana elements are written by name, so lists that aren't registered need an enterprise number
+	list := ipfix.NewBasicList("list", annotated, 0)
+	list.Pen = 35566
+	ordered := ipfix.NewBasicListWithSemantic("orderedList", octetDeltaCount, 2, ipfix.OrderedSemantic)
+	ordered.Pen = 35566
+	inner := ipfix.NewBasicListWithSemantic("inner", address, 0, ipfix.NoneOfSemantic)
+	inner.Pen = 35566
+	outer := ipfix.NewBasicList("outer", inner, 0)
+	outer.Pen = 35566
+	for _, ie := range []ipfix.InformationElement{
+		octetDeltaCount,
+		reduced,
+		octetDeltaCount.Reverse(),
+		address,
+		annotated,
+		units,
+		ipfix.NewInformationElement("", 35566, 3, ipfix.OctetArrayType, 8),
+		ipfix.NewInformationElement("wide", 35566, 4, ipfix.Unsigned256Type, 0),
+		list,
+		ordered,
+		outer,
+	} {
+		spec := ie.String()
+		parsed, err := ipfix.MakeIEFromSpec([]byte(spec))
+		if err != nil {
+			t.Errorf("%q: %v", spec, err)
+			continue
+		}
+		// status and description are not part of iespecs
+		parsed.Status, parsed.Description = 0, ""
+		ie.Status, ie.Description = 0, ""
+		if !reflect.DeepEqual(parsed, ie) {
+			t.Errorf("%q: parsed %#v, expected %#v", spec, parsed, ie)
+		}
+	}
+}
+
+func TestIESpecErrors(t *testing.T) {
+	for _, test := range []struct {
+		spec string
+		err  string
+	}{
+		{"a(1)<unknown>", "1:6: unknown type \"unknown\""},
+		{"a(1<unsigned8>", "1:4: expected ')', got '<'"},
+		{"a(x)<unsigned8>", "1:3: expected pen or id"},
+		{"a(70000)<unsigned8>", "1:3: id 70000 out of range"},
+		{"a(1/70000)<unsigned8>", "1:5: id 70000 out of range"},
+		{"a(1)<unsigned8", "1:15: expected '>' at end of line"},
+		{"a(1)<unsigned8>[2]", "1:16: invalid length 2 for type unsigned8"},
+		{"a(1)<ipv4Address>[8]", "1:18: invalid length 8 for type ipv4Address"},
+		{"a(1)<unsigned8>[x]", "1:17: expected length"},
+		{"a(1)<unsigned8>{counter}", "1:17: unknown semantics \"counter\""},
+		{"a(1)<unsigned8>{allOf}", "1:17: unknown semantics \"allOf\""},
+		{"a(1)<unsigned8>{,, 1-x}", "1:20: invalid range \"1-x\""},
+		{"a(1)<unsigned8>{,,,x}", "1:20: unexpected \"x\"; expected only semantics, units, and range"},
+		{"a(1)<unsigned8>{quantity", "1:16: missing '}'"},
+		{"a(1)<unsigned8> x", "1:17: unexpected \"x\""},
+		{"noSuchElement", "1:1: unknown information element \"noSuchElement\""},
+		{"# comment\n\n  +a(1)<unsigned8>", "3:4: list element without basicList"},
+		{"a(1)<unsigned8>\n+b(2)<unsigned8>", "2:2: list element for unsigned8, which is not a basicList"},
+		{"l(1)<basicList>\n+b(2)<unsigned8>\n+c(3)<unsigned8>", "3:2: second list element for basicList"},
+		{"", "1:1: expected one information element, got 0"},
+		{"a(1)<unsigned8>\nb(2)<unsigned8>", "1:1: expected one information element, got 2"},
+	} {
+		_, err := ipfix.MakeIEFromSpec([]byte(test.spec))
+		if _, ok := err.(ipfix.IESpecError); !ok {
+			t.Errorf("%q: expected IESpecError, got %v", test.spec, err)
+			continue
+		}
+		if want := "ipfix: Could not parse iespec at " + test.err; err.Error() != want {
+			t.Errorf("%q: expected %q, got %q", test.spec, want, err.Error())
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package ipfix
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// ianaRegistryID is the id of the information element registry in the iana xml file
+const ianaRegistryID = "ipfix-information-elements"
+
+// ParseIANAXML reads the information elements from the iana registry in xml format as published at
+// https://www.iana.org/assignments/ipfix/ipfix.xml. Reserved and unassigned ranges, and elements with
+// unknown data types are skipped.
+func ParseIANAXML(rd io.Reader) ([]InformationElement, error) {
+	type record struct {
+		Name        string          `xml:"name"`
+		DataType    []byte          `xml:"dataType"`
+		Semantics   []byte          `xml:"dataTypeSemantics"`
+		ElementID   string          `xml:"elementId"`
+		Status      []byte          `xml:"status"`
+		Description ianaDescription `xml:"description"`
+		Units       string          `xml:"units"`
+		Range       string          `xml:"range"`
+	}
+	dec := xml.NewDecoder(rd)
+	// search for the information element registry
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("ipfix: No registry with id '%s' found", ianaRegistryID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok && start.Name.Local == "registry" && xmlAttr(start, "id") == ianaRegistryID {
+			break
+		}
+	}
+	var elements []InformationElement
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			// end of the information element registry
+			return elements, nil
+		case xml.StartElement:
+			if t.Name.Local != "record" {
+				// title, notes, and sub-registries
+				if err := dec.Skip(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			var rec record
+			if err := dec.DecodeElement(&rec, &t); err != nil {
+				return nil, err
+			}
+			name := strings.TrimSpace(rec.Name)
+			typ := NameToType(bytes.TrimSpace(rec.DataType))
+			// ranges of reserved or unassigned ids fail to parse
+			id, err := strconv.ParseUint(strings.TrimSpace(rec.ElementID), 10, 15)
+			if name == "" || err != nil || id == 0 || typ == IllegalType {
+				continue
+			}
+			// the iana registry only holds elements without enterprise number
+			ie := NewInformationElement(name, ianaPen, uint16(id), typ, 0)
+			var ok bool
+			if semantics := bytes.TrimSpace(rec.Semantics); len(semantics) != 0 {
+				if ie.Semantics, ok = NameToSemantics(semantics); !ok {
+					return nil, fmt.Errorf("ipfix: Unknown semantics '%s' of %s", semantics, name)
+				}
+			}
+			if status := bytes.TrimSpace(rec.Status); len(status) != 0 {
+				if ie.Status, ok = NameToStatus(status); !ok {
+					return nil, fmt.Errorf("ipfix: Unknown status '%s' of %s", status, name)
+				}
+			}
+			ie.Units = strings.TrimSpace(rec.Units)
+			ie.Range = strings.TrimSpace(rec.Range)
+			ie.Description = rec.Description.text
+			elements = append(elements, ie)
+		}
+	}
+}
+
+func xmlAttr(start xml.StartElement, name string) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// ianaDescription is the description of an information element in the iana xml registry. Paragraphs are
+// joined with whitespace collapsed, artwork is kept as is, and references are written as [RFC1234].
+type ianaDescription struct {
+	text string
+}
+
+func (d *ianaDescription) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var parts []string
+	var text strings.Builder
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "paragraph", "artwork":
+				text.Reset()
+			case "xref":
+				if data := xmlAttr(t, "data"); data != "" {
+					text.WriteString("[" + strings.Replace(data, "rfc", "RFC", 1) + "]")
+				}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "paragraph":
+				if paragraph := strings.Join(strings.Fields(text.String()), " "); paragraph != "" {
+					parts = append(parts, paragraph)
+				}
+			case "artwork":
+				lines := strings.Split(strings.Trim(text.String(), "\n"), "\n")
+				for i, line := range lines {
+					lines[i] = strings.TrimRight(line, " \t")
+				}
+				parts = append(parts, strings.Join(lines, "\n"))
+			case start.Name.Local:
+				d.text = strings.Join(parts, "\n\n")
+				return nil
+			}
+		}
+	}
+}
+
+// jsonElement is an information element in the format read by Registry.LoadJSON
+type jsonElement struct {
+	Name        string       `json:"name"`
+	Pen         uint32       `json:"pen"`
+	ID          uint16       `json:"id"`
+	Type        string       `json:"type"`
+	Length      uint16       `json:"length"`
+	Semantics   string       `json:"semantics"`
+	Units       string       `json:"units"`
+	Range       string       `json:"range"`
+	Status      string       `json:"status"`
+	Description string       `json:"description"`
+	Element     *jsonElement `json:"element"`
+}
+
+func (j jsonElement) informationElement() (InformationElement, error) {
+	t := NameToType([]byte(j.Type))
+	if t == IllegalType {
+		return InformationElement{}, fmt.Errorf("ipfix: Unknown type '%s' of %s", j.Type, j.Name)
+	}
+	if t == SubTemplateListType || t == SubTemplateMultiListType {
+		// these reference templates of a MessageStream; see NewSubTemplateList
+		return InformationElement{}, fmt.Errorf("ipfix: %s %s can't be loaded", t, j.Name)
+	}
+	var ie InformationElement
+	if t == BasicListType {
+		if j.Element == nil {
+			return InformationElement{}, fmt.Errorf("ipfix: basicList %s without element", j.Name)
+		}
+		element, err := j.Element.informationElement()
+		if err != nil {
+			return InformationElement{}, err
+		}
+		semantic := UndefinedSemantic
+		if j.Semantics != "" {
+			var ok bool
+			if semantic, ok = nameToStructuredSemantic([]byte(j.Semantics)); !ok {
+				return InformationElement{}, fmt.Errorf("ipfix: Unknown list semantic '%s' of %s", j.Semantics, j.Name)
+			}
+		}
+		ie = NewBasicListWithSemantic(j.Name, element, 0, semantic)
+		ie.Pen = j.Pen
+		ie.ID = j.ID
+		if j.Length != 0 {
+			ie.Length = j.Length
+		}
+	} else {
+		if j.Element != nil {
+			return InformationElement{}, fmt.Errorf("ipfix: %s is not a basicList but has an element", j.Name)
+		}
+		ie = NewInformationElement(j.Name, j.Pen, j.ID, t, j.Length)
+		if j.Semantics != "" {
+			var ok bool
+			if ie.Semantics, ok = NameToSemantics([]byte(j.Semantics)); !ok {
+				return InformationElement{}, fmt.Errorf("ipfix: Unknown semantics '%s' of %s", j.Semantics, j.Name)
+			}
+		}
+	}
+	if !validLength(t, ie.Length) {
+		return InformationElement{}, fmt.Errorf("ipfix: Invalid length %d of %s", ie.Length, j.Name)
+	}
+	if j.Status != "" {
+		var ok bool
+		if ie.Status, ok = NameToStatus([]byte(j.Status)); !ok {
+			return InformationElement{}, fmt.Errorf("ipfix: Unknown status '%s' of %s", j.Status, j.Name)
+		}
+	}
+	ie.Units = j.Units
+	ie.Range = j.Range
+	ie.Description = j.Description
+	return ie, nil
+}
+
+// LoadIANAXML registers the information elements of the iana registry in xml format read from rd in r.
+// See ParseIANAXML. Like for all Load functions, either all elements are registered or none.
+func (r *Registry) LoadIANAXML(rd io.Reader) error {
+	elements, err := ParseIANAXML(rd)
+	if err != nil {
+		return err
+	}
+	return r.registerAll(elements)
+}
+
+// LoadIESpec registers the information elements of the iespec file read from rd in r. The format is
+// described at ParseIESpecs; names refer to elements registered in r or its parents.
+func (r *Registry) LoadIESpec(rd io.Reader) error {
+	spec, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return err
+	}
+	elements, err := r.ParseIESpecs(spec)
+	if err != nil {
+		return err
+	}
+	return r.registerAll(elements)
+}
+
+// LoadJSON registers the information elements read from rd in r. The input is an array of objects like
+//
+//	[{"name": "octetDeltaCount", "pen": 0, "id": 1, "type": "unsigned64", "semantics": "deltaCounter",
+//	  "units": "octets"}]
+//
+// with the optional fields length, semantics, units, range, status, and description, which default to
+// the default length of the type and empty values. basicLists hold the list element in the field element
+// and the list semantic in semantics. subTemplateLists and subTemplateMultiLists are rejected, since they
+// must be created with MessageStream.NewSubTemplateList or MessageStream.NewSubTemplateMultiList.
+func (r *Registry) LoadJSON(rd io.Reader) error {
+	var list []jsonElement
+	if err := json.NewDecoder(rd).Decode(&list); err != nil {
+		return err
+	}
+	elements := make([]InformationElement, len(list))
+	for i, j := range list {
+		ie, err := j.informationElement()
+		if err != nil {
+			return err
+		}
+		elements[i] = ie
+	}
+	return r.registerAll(elements)
+}
+
+// registerAll registers all elements in r or none of them, if any name is already registered in r or
+// used twice.
+func (r *Registry) registerAll(elements []InformationElement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make(map[string]bool, len(elements))
+	for _, ie := range elements {
+		if _, ok := r.names[ie.Name]; ok || names[ie.Name] {
+			return fmt.Errorf("ipfix: Information element with name '%s' already registered", ie.Name)
+		}
+		names[ie.Name] = true
+	}
+	for _, ie := range elements {
+		r.register(ie)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package ipfix_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+func ExampleRegistry_LoadJSON() {
+	r := ipfix.MakeRegistry(nil)
+	err := r.LoadJSON(strings.NewReader(`[
+		{"name": "vendorOctets", "pen": 12345, "id": 1, "type": "unsigned64", "semantics": "deltaCounter", "units": "octets"},
+		{"name": "vendorHosts", "pen": 12345, "id": 2, "type": "basicList", "semantics": "allOf",
+		 "element": {"name": "vendorHost", "pen": 12345, "id": 3, "type": "string"}}
+	]`))
+	if err != nil {
+		fmt.Println("LoadJSON failed:", err)
+		return
+	}
+	for _, ie := range r.InformationElements() {
+		fmt.Println(ie)
+	}
+	// Output:
+	// vendorOctets(12345/1)<unsigned64>{deltaCounter,octets}
+	// vendorHosts(12345/2)<basicList>{allOf}
+	// +vendorHost(12345/3)<string>
+}
+
+func ExampleRegistry_LoadIESpec() {
+	iana := ipfix.MakeRegistry(nil)
+	ipfix.LoadIANASpecInto(iana)
+
+	r := ipfix.MakeRegistry(iana)
+	err := r.LoadIESpec(strings.NewReader(`
+# vendor elements
+vendorOctets(12345/1)<unsigned64>{deltaCounter,octets}
+vendorAddresses(12345/2)<basicList>{allOf}
++sourceIPv4Address
+`))
+	if err != nil {
+		fmt.Println("LoadIESpec failed:", err)
+		return
+	}
+	ie, _ := r.GetByID(12345, 2)
+	element, _ := ie.ListElement()
+	fmt.Println(ie.Name, element.Name, element.ID)
+	// Output:
+	// vendorAddresses sourceIPv4Address 8
+}
+
+func TestLoadIANAXML(t *testing.T) {
+	f, err := os.Open("spec_iana.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	loaded := ipfix.MakeRegistry(nil)
+	if err := loaded.LoadIANAXML(f); err != nil {
+		t.Fatal(err)
+	}
+	generated := ipfix.MakeRegistry(nil)
+	ipfix.LoadIANASpecInto(generated)
+	a, b := loaded.InformationElements(), generated.InformationElements()
+	if len(a) != len(b) {
+		t.Fatalf("expected %d elements, got %d", len(b), len(a))
+	}
+	for i := range a {
+		if !reflect.DeepEqual(a[i], b[i]) {
+			t.Errorf("expected %#v, got %#v", b[i], a[i])
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	for _, test := range []struct {
+		load  func(r *ipfix.Registry) error
+		error string
+	}{
+		{func(r *ipfix.Registry) error {
+			return r.LoadIANAXML(strings.NewReader(`<registry id="other"></registry>`))
+		}, "ipfix: No registry with id 'ipfix-information-elements' found"},
+		{func(r *ipfix.Registry) error {
+			return r.LoadIESpec(strings.NewReader("a(1/1)<unsigned8>\nb(1/2)<nothing>"))
+		}, `ipfix: Could not parse iespec at 2:8: unknown type "nothing"`},
+		{func(r *ipfix.Registry) error {
+			return r.LoadIESpec(strings.NewReader("a(1/1)<unsigned8>\na(1/2)<unsigned8>"))
+		}, "ipfix: Information element with name 'a' already registered"},
+		{func(r *ipfix.Registry) error {
+			return r.LoadJSON(strings.NewReader(`[{"name": "a", "type": "nothing"}]`))
+		}, "ipfix: Unknown type 'nothing' of a"},
+		{func(r *ipfix.Registry) error {
+			return r.LoadJSON(strings.NewReader(`[{"name": "a", "type": "ipv4Address", "length": 2}]`))
+		}, "ipfix: Invalid length 2 of a"},
+		{func(r *ipfix.Registry) error {
+			return r.LoadJSON(strings.NewReader(`[{"name": "a", "type": "basicList"}]`))
+		}, "ipfix: basicList a without element"},
+		{func(r *ipfix.Registry) error {
+			return r.LoadJSON(strings.NewReader(`[{"name": "a", "type": "unsigned8", "semantics": "none"}]`))
+		}, "ipfix: Unknown semantics 'none' of a"},
+		{func(r *ipfix.Registry) error {
+			return r.LoadJSON(strings.NewReader(`[{"name": "a", "type": "subTemplateList"}]`))
+		}, "ipfix: subTemplateList a can't be loaded"},
+		{func(r *ipfix.Registry) error {
+			return r.LoadJSON(strings.NewReader(`[{"name": "a", "type": "subTemplateMultiList"}]`))
+		}, "ipfix: subTemplateMultiList a can't be loaded"},
+	} {
+		err := test.load(ipfix.MakeRegistry(nil))
+		if err == nil || err.Error() != test.error {
+			t.Errorf("expected error %q, got %v", test.error, err)
+		}
+	}
+}
+
+func TestLoadIANAXMLUnsigned256(t *testing.T) {
+	// spec_iana.xml predates the first unsigned256 elements; newer registries hold records like this one
+	const registry = `<registry xmlns="http://www.iana.org/assignments" id="ipfix">
+<registry id="ipfix-information-elements">
+<title>IPFIX Information Elements</title>
+<record>
+<name>exampleFlags256</name>
+<dataType>unsigned256</dataType>
+<dataTypeSemantics>flags</dataTypeSemantics>
+<elementId>1000</elementId>
+<status>current</status>
+<description><paragraph>An example flag field.</paragraph></description>
+</record>
+</registry>
+</registry>`
+	r := ipfix.MakeRegistry(nil)
+	if err := r.LoadIANAXML(strings.NewReader(registry)); err != nil {
+		t.Fatal(err)
+	}
+	ie, ok := r.GetByID(0, 1000)
+	if !ok || ie.Name != "exampleFlags256" || ie.Type != ipfix.Unsigned256Type || ie.Length != 32 || ie.Semantics != ipfix.FlagsSemantics {
+		t.Fatalf("expected exampleFlags256 of type unsigned256, got %v", ie)
+	}
+
+	buf := new(bytes.Buffer)
+	s, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := s.AddTemplate(0, ie)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := new(big.Int).Lsh(big.NewInt(1), 255)
+	if err := s.SendData(0, id, value); err != nil {
+		t.Fatal(err)
+	}
+	s.Flush(0)
+	msg, err := ipfix.DecodeMessage(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := ipfix.MakeTemplateCache()
+	cache.Registry = r
+	cache.Update(nil, msg)
+	for _, set := range msg.Sets {
+		if set, ok := set.(ipfix.DataSet); ok {
+			records, err := cache.DecodeDataSet(nil, msg.ObservationDomainID, set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, ok := records[0].Values[0].(*big.Int); !ok || got.Cmp(value) != 0 {
+				t.Errorf("expected %v, got %v", value, records[0].Values[0])
+			}
+		}
+	}
+}
+
+func TestLoadAtomic(t *testing.T) {
+	r := ipfix.MakeRegistry(nil)
+	if err := r.LoadIESpec(strings.NewReader("a(1/1)<unsigned8>")); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.LoadIESpec(strings.NewReader("b(1/2)<unsigned8>\na(1/3)<unsigned8>")); err == nil {
+		t.Fatal("expected error for already registered name")
+	}
+	if err := r.LoadJSON(strings.NewReader(`[{"name": "c", "pen": 1, "id": 4, "type": "unsigned8"},
+		{"name": "c", "pen": 1, "id": 5, "type": "unsigned8"}]`)); err == nil {
+		t.Fatal("expected error for duplicate name")
+	}
+	if len(r.InformationElements()) != 1 {
+		t.Errorf("expected only a to be registered, got %v", r.InformationElements())
+	}
+}
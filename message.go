@@ -9,6 +9,15 @@ import (
 
 // MessageStream represents an ipfix message stream.
 type MessageStream struct {
+	// Strict enables strict conversion of values in SendData and SendStruct. Negative values for unsigned
+	// information elements, unsigned values exceeding signed information elements, and floats that are
+	// NaN, infinite, or out of range for integer information elements result in a RangeError instead of
+	// being converted silently.
+	Strict bool
+	// Registry is used for looking up the information elements named in the tags of AddStructTemplate.
+	// nil uses the DefaultRegistry.
+	Registry *Registry
+
 	w                 io.Writer
 	buffer            scratchBuffer
 	length            []byte
@@ -93,38 +102,120 @@ RETRY:
 // time either as a time.Time value or as one of the provieded ipfix time types. A template id is
 // returned that can be used with SendData. In case of error an error value is provided.
 func (m *MessageStream) AddTemplate(now interface{}, elements ...InformationElement) (id int, err error) {
-	id = len(m.templates) + 256
-	newTemplate := template{int16(id), elements}
+	return m.addTemplate(now, 0, elements)
+}
+
+// AddOptionsTemplate adds the given InformationElement as a new options template according to RFC7011
+// section 3.4.2.2. The first scopeCount elements are the scope fields, which must be at least one. now
+// must be the current or exported time either as a time.Time value or as one of the provieded ipfix time
+// types. A template id is returned that can be used with SendData. In case of error an error value is
+// provided.
+func (m *MessageStream) AddOptionsTemplate(now interface{}, scopeCount int, elements ...InformationElement) (id int, err error) {
+	if scopeCount < 1 || scopeCount > len(elements) {
+		return 0, errors.New("scope count must be between 1 and the number of elements")
+	}
+	return m.addTemplate(now, uint16(scopeCount), elements)
+}
+
+func (m *MessageStream) addTemplate(now interface{}, scope uint16, elements []InformationElement) (id int, err error) {
+	// reuse ids of withdrawn templates
+	index := len(m.templates)
+	for i, t := range m.templates {
+		if t == nil {
+			index = i
+			break
+		}
+	}
+	id = index + 256
+	newTemplate := template{identifier: int16(id), scope: scope, elements: elements}
 	if err = m.sendRecord(newTemplate, now); err == nil {
-		m.templates = append(m.templates, &newTemplate)
+		if index == len(m.templates) {
+			m.templates = append(m.templates, &newTemplate)
+		} else {
+			m.templates[index] = &newTemplate
+		}
 	}
 	return
 }
 
+// WithdrawTemplate withdraws the template or options template with the given id according to RFC7011
+// section 8.1. Afterwards, SendData fails for this id and the id can be reused by AddTemplate.
+// It returns an error if the template can not be found or the send failed.
+func (m *MessageStream) WithdrawTemplate(now interface{}, id int) (err error) {
+	t := m.lookupTemplate(id)
+	if t == nil {
+		return UnknownTemplateError(id)
+	}
+	if err = m.sendRecord(templateWithdrawal{int16(id), t.id()}, now); err == nil {
+		m.templates[id-256] = nil
+	}
+	return
+}
+
+// WithdrawAllTemplates withdraws all templates and options templates according to RFC7011 section 8.1.
+// Afterwards, all template ids can be reused.
+func (m *MessageStream) WithdrawAllTemplates(now interface{}) (err error) {
+	if err = m.sendRecord(templateWithdrawal{templateSetID, templateSetID}, now); err != nil {
+		return
+	}
+	if err = m.sendRecord(templateWithdrawal{optionsTemplateSetID, optionsTemplateSetID}, now); err != nil {
+		return
+	}
+	m.templates = nil
+	return
+}
+
 // SendTemplate resend an existing template by id.
 // It returns an error if the template can not be found or the send failed.
 func (m *MessageStream) SendTemplate(now interface{}, id int) (err error) {
-	if id >= len(m.templates) {
+	t := m.lookupTemplate(id)
+	if t == nil {
 		return UnknownTemplateError(id)
 	}
-	newTemplate := m.templates[id]
-	err = m.sendRecord(newTemplate, now)
+	err = m.sendRecord(t, now)
 	return
 }
 
+// registry returns the Registry for looking up information elements
+func (m *MessageStream) registry() *Registry {
+	if m.Registry == nil {
+		return DefaultRegistry
+	}
+	return m.Registry
+}
+
+// lookupTemplate returns the template with the given id or nil if no such template exists.
+func (m *MessageStream) lookupTemplate(id int) *template {
+	index := id - 256
+	if index < 0 || index >= len(m.templates) {
+		return nil
+	}
+	return m.templates[index]
+}
+
+// sendTemplates resends all templates, e.g., for refreshing them on unreliable transports.
+func (m *MessageStream) sendTemplates(now interface{}) error {
+	for _, t := range m.templates {
+		if t == nil {
+			continue
+		}
+		if err := m.sendRecord(t, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SendData sends the given values for the given template id (Can be allocated with AddTemplate).
 // now must be the current or exported time either as a time.Time value or as one of the provieded ipfix time types.
 // Template InformationElements and given data types must match. Numeric types are converted automatically.
 // In case of error an error is returned.
 func (m *MessageStream) SendData(now interface{}, template int, data ...interface{}) (err error) {
-	id := template - 256
-	if id < 0 || id >= len(m.templates) {
-		return UnknownTemplateError(template)
-	}
-	t := m.templates[id]
+	t := m.lookupTemplate(template)
 	if t == nil {
 		return UnknownTemplateError(template)
 	}
+	m.currentDataRecord.strictConversion = m.Strict
 	err = t.assignDataRecord(&m.currentDataRecord, data...)
 	if err != nil {
 		return
@@ -132,6 +223,24 @@ func (m *MessageStream) SendData(now interface{}, template int, data ...interfac
 	return m.sendRecord(&m.currentDataRecord, now)
 }
 
+// exportTime returns now, which is the current or exported time as a time.Time value or one of the ipfix
+// time types, as time.Time. Other values are replaced by the current time.
+func exportTime(now interface{}) time.Time {
+	switch v := now.(type) {
+	case time.Time:
+		return v
+	case DateTimeSeconds:
+		return time.Unix(int64(v), 0)
+	case DateTimeMilliseconds:
+		return time.Unix(0, int64(v)*int64(time.Millisecond))
+	case DateTimeMicroseconds:
+		return time.Unix(0, int64(v)*int64(time.Microsecond))
+	case DateTimeNanoseconds:
+		return time.Unix(0, int64(v))
+	}
+	return time.Now()
+}
+
 // Flush must be called before the underlying writer is closed. This function finishes and flushes
 // eventual not yet finalized messages. This does not flush the underlying buffer!
 func (m *MessageStream) Flush(now interface{}) (err error) {
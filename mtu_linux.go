@@ -0,0 +1,28 @@
+//go:build linux
+// +build linux
+
+package ipfix
+
+import (
+	"net"
+	"syscall"
+)
+
+// pathMTU returns the path mtu of the connected socket conn as known by the kernel.
+func pathMTU(conn *net.UDPConn, ipv6 bool) (mtu int, ok bool) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return 0, false
+	}
+	err = raw.Control(func(fd uintptr) {
+		if ipv6 {
+			mtu, err = syscall.GetsockoptInt(int(fd), syscall.IPPROTO_IPV6, syscall.IPV6_MTU)
+		} else {
+			mtu, err = syscall.GetsockoptInt(int(fd), syscall.IPPROTO_IP, syscall.IP_MTU)
+		}
+	})
+	if err != nil || mtu <= 0 {
+		return 0, false
+	}
+	return mtu, true
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package ipfix
+
+import (
+	"net"
+)
+
+// pathMTU is not supported on this platform; the interface mtu is used instead.
+func pathMTU(conn *net.UDPConn, ipv6 bool) (mtu int, ok bool) {
+	return 0, false
+}
@@ -0,0 +1,88 @@
+package ipfix
+
+import "net/netip"
+
+// prefixLengthElements maps the iana address elements to the information elements holding their prefix
+// length. Struct fields of type netip.Prefix fill both elements.
+var prefixLengthElements = map[uint16]uint16{
+	8:   9,  // sourceIPv4Address: sourceIPv4PrefixLength
+	12:  13, // destinationIPv4Address: destinationIPv4PrefixLength
+	27:  29, // sourceIPv6Address: sourceIPv6PrefixLength
+	28:  30, // destinationIPv6Address: destinationIPv6PrefixLength
+	44:  9,  // sourceIPv4Prefix: sourceIPv4PrefixLength
+	45:  13, // destinationIPv4Prefix: destinationIPv4PrefixLength
+	170: 29, // sourceIPv6Prefix: sourceIPv6PrefixLength
+	169: 30, // destinationIPv6Prefix: destinationIPv6PrefixLength
+	47:  91, // mplsTopLabelIPv4Address: mplsTopLabelPrefixLength
+	140: 91, // mplsTopLabelIPv6Address: mplsTopLabelPrefixLength
+}
+
+// prefixLengthElement returns the information element registered in r holding the prefix length for
+// the address element ie.
+func (ie InformationElement) prefixLengthElement(r *Registry) (InformationElement, bool) {
+	if ie.Pen != ianaPen {
+		return InformationElement{}, false
+	}
+	id, ok := prefixLengthElements[ie.ID]
+	if !ok {
+		return InformationElement{}, false
+	}
+	return r.lookup(ianaPen, id)
+}
+
+// serializeAddrTo writes addr with the given length. IPv4-mapped IPv6 addresses are unmapped for
+// ipv4Address, and IPv4 addresses are mapped for ipv6Address. The zero Addr is written as zeros.
+func serializeAddrTo(buffer scratchBuffer, t Type, addr netip.Addr, length int) (int, error) {
+	if length == 0 {
+		length = int(DefaultSize[t])
+	}
+	if !addr.IsValid() {
+		return serializeOctetsTo(buffer, nil, length)
+	}
+	switch t {
+	case Ipv4AddressType:
+		if addr = addr.Unmap(); addr.Is4() && (length == 4 || length == int(VariableLength)) {
+			a := addr.As4()
+			return serializeOctetsTo(buffer, a[:], length)
+		}
+	case Ipv6AddressType:
+		if length == 16 || length == int(VariableLength) {
+			a := addr.As16()
+			return serializeOctetsTo(buffer, a[:], length)
+		}
+	case OctetArrayType:
+		if addr.Is4() {
+			a := addr.As4()
+			return serializeOctetsTo(buffer, a[:], length)
+		}
+		a := addr.As16()
+		return serializeOctetsTo(buffer, a[:], length)
+	}
+	return 0, ConversionError{t, addr}
+}
+
+// decodeAddrFrom decodes an address of type t from the start of b as netip.Addr and returns the number
+// of consumed bytes.
+func decodeAddrFrom(t Type, b []byte, length int) (interface{}, int, error) {
+	if length == 0 {
+		length = int(DefaultSize[t])
+	}
+	header := 0
+	if length == int(VariableLength) {
+		var err error
+		if length, header, err = decodeVariableLength(t, b); err != nil {
+			return nil, 0, err
+		}
+		b = b[header:]
+	}
+	if length != int(DefaultSize[t]) {
+		return nil, 0, SizeError{t, length}
+	}
+	if len(b) < length {
+		return nil, 0, SizeError{t, len(b)}
+	}
+	if t == Ipv4AddressType {
+		return netip.AddrFrom4(*(*[4]byte)(b)), header + length, nil
+	}
+	return netip.AddrFrom16(*(*[16]byte)(b)), header + length, nil
+}
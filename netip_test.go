@@ -0,0 +1,177 @@
+package ipfix_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/netip"
+	"testing"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+func TestNetIPSendData(t *testing.T) {
+	v4 := ipfix.NewInformationElement("v4", 0, 8, ipfix.Ipv4AddressType, 0)
+	v6 := ipfix.NewInformationElement("v6", 0, 27, ipfix.Ipv6AddressType, 0)
+	length := ipfix.NewInformationElement("length", 0, 9, ipfix.Unsigned8Type, 0)
+	for _, test := range []struct {
+		ie    ipfix.InformationElement
+		value interface{}
+		want  []byte
+	}{
+		{v4, netip.MustParseAddr("10.0.0.1"), []byte{10, 0, 0, 1}},
+		{v4, netip.MustParseAddr("::ffff:10.0.0.1"), []byte{10, 0, 0, 1}},
+		{v4, netip.Addr{}, []byte{0, 0, 0, 0}},
+		{v6, netip.MustParseAddr("2001:db8::1"), []byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}},
+		{v6, netip.MustParseAddr("10.0.0.1"), []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 10, 0, 0, 1}},
+		{v4, netip.MustParsePrefix("10.1.0.0/16"), []byte{10, 1, 0, 0}},
+		{v4, netip.MustParsePrefix("10.1.2.3/16"), []byte{10, 1, 0, 0}},
+		{length, netip.MustParsePrefix("10.1.0.0/16"), []byte{16}},
+		{v4, netip.MustParseAddr("2001:db8::1"), nil},
+		{length, netip.Prefix{}, nil},
+	} {
+		buf := new(bytes.Buffer)
+		s, err := ipfix.MakeMessageStream(buf, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id, err := s.AddTemplate(0, test.ie)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.SendData(0, id, test.value)
+		if test.want == nil {
+			if _, ok := err.(ipfix.ConversionError); !ok {
+				t.Errorf("%s %v: expected ConversionError, got %v", test.ie.Name, test.value, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %v: %v", test.ie.Name, test.value, err)
+			continue
+		}
+		s.Flush(0)
+		if got := buf.Bytes()[buf.Len()-len(test.want):]; !bytes.Equal(got, test.want) {
+			t.Errorf("%s %v: expected % x, got % x", test.ie.Name, test.value, test.want, got)
+		}
+	}
+}
+
+func TestNetIPStruct(t *testing.T) {
+	ipfix.LoadIANASpec()
+	type flow struct {
+		Source      netip.Prefix `ipfix:"sourceIPv4Address"`
+		Destination netip.Addr   `ipfix:"destinationIPv6Address"`
+		Next        netip.Addr   `ipfix:"ipNextHopIPv4Address"`
+	}
+	value := flow{
+		Source:      netip.MustParsePrefix("10.1.2.3/16"),
+		Destination: netip.MustParseAddr("2001:db8::1"),
+		Next:        netip.MustParseAddr("::ffff:192.168.0.1"),
+	}
+
+	structs := new(bytes.Buffer)
+	s, err := ipfix.MakeMessageStream(structs, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := s.AddStructTemplate(0, flow{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendStruct(0, id, &value); err != nil {
+		t.Fatal(err)
+	}
+	s.Flush(0)
+
+	// the prefix fills sourceIPv4Address and sourceIPv4PrefixLength
+	values := new(bytes.Buffer)
+	s, err = ipfix.MakeMessageStream(values, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var elements []ipfix.InformationElement
+	for _, name := range []string{"sourceIPv4Address", "sourceIPv4PrefixLength", "destinationIPv6Address", "ipNextHopIPv4Address"} {
+		ie, err := ipfix.GetInformationElement(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		elements = append(elements, ie)
+	}
+	id, err = s.AddTemplate(0, elements...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendData(0, id, value.Source, value.Source, value.Destination, value.Next); err != nil {
+		t.Fatal(err)
+	}
+	s.Flush(0)
+	if !bytes.Equal(structs.Bytes(), values.Bytes()) {
+		t.Errorf("SendStruct differs from SendData:\n% x\n% x", structs.Bytes(), values.Bytes())
+	}
+	// host bits of the prefix are not exported
+	if !bytes.Contains(structs.Bytes(), []byte{10, 1, 0, 0, 16}) {
+		t.Errorf("SendStruct didn't mask the prefix: % x", structs.Bytes())
+	}
+
+	s, err = ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err = s.AddStructTemplate(0, flow{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := s.SendStruct(0, id, &value); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("SendStruct with netip types allocated %v times", allocs)
+	}
+}
+
+func TestNetIPDecode(t *testing.T) {
+	buf := new(bytes.Buffer)
+	s, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elements := []ipfix.InformationElement{
+		ipfix.NewInformationElement("v4", 0, 8, ipfix.Ipv4AddressType, 0),
+		ipfix.NewInformationElement("v6", 0, 27, ipfix.Ipv6AddressType, 0),
+		ipfix.NewBasicList("list", ipfix.NewInformationElement("v4", 0, 8, ipfix.Ipv4AddressType, 0), 0),
+	}
+	id, err := s.AddTemplate(0, elements...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v4 := netip.MustParseAddr("10.0.0.1")
+	v6 := netip.MustParseAddr("2001:db8::1")
+	if err := s.SendData(0, id, v4, v6, []netip.Addr{v4, v4}); err != nil {
+		t.Fatal(err)
+	}
+	s.Flush(0)
+
+	msg, err := ipfix.DecodeMessage(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := ipfix.MakeTemplateCache()
+	cache.NetIP = true
+	cache.Update(nil, msg)
+	records, err := cache.DecodeDataSet(nil, 0, msg.Sets[1].(ipfix.DataSet))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	values := records[0].Values
+	if values[0] != v4 || values[1] != v6 {
+		t.Errorf("expected %v %v, got %v %v", v4, v6, values[0], values[1])
+	}
+	if list := values[2].([]interface{}); len(list) != 2 || list[0] != v4 || list[1] != v4 {
+		t.Errorf("expected [%v %v], got %v", v4, v4, list)
+	}
+}
@@ -0,0 +1,235 @@
+package ipfix_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+func ExampleMakeMessageReader() {
+	// buf holds the ipfix stream that will be read
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC) // simulated fixed time
+
+	// Write a message with one template and two data records
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 5)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+	b, _ := ipfix.GetInformationElement("sourceIPv4Address")
+	id, err := msgStream.AddTemplate(now, a, b)
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+	msgStream.SendData(now, id, uint64(5), net.IP{192, 168, 0, 1})
+	msgStream.SendData(now, id, uint64(10), net.IP{192, 168, 0, 2})
+	msgStream.Flush(now)
+
+	// Read back all the messages
+	reader := ipfix.MakeMessageReader(buf)
+	for {
+		msg, err := reader.ReadMessage()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Println("MessageReader.ReadMessage failed:", err)
+			return
+		}
+		fmt.Println(msg.Length, msg.ExportTime, msg.SequenceNumber, msg.ObservationDomainID)
+		for _, set := range msg.Sets {
+			switch set := set.(type) {
+			case ipfix.TemplateSet:
+				for _, rec := range set.Records {
+					fmt.Println("template", rec.TemplateID, rec.Elements)
+				}
+			case ipfix.DataSet:
+				fmt.Printf("data %d % x\n", set.TemplateID, set.Data)
+			}
+		}
+	}
+	// Output:
+	// 60 2018-01-01 00:00:00 +0000 UTC 0 5
+	// template 256 [(0/1)<<bad>>[8] (0/8)<<bad>>[4]]
+	// data 256 00 00 00 00 00 00 00 05 c0 a8 00 01 00 00 00 00 00 00 00 0a c0 a8 00 02
+}
+
+func ExampleDecodeMessage() {
+	// a message with a template withdrawal for template 256
+	_, err := ipfix.DecodeMessage([]byte{0x00, 0x09, 0x00, 0x18, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x00, 0x02, 0x00, 0x08, 0x01, 0x00, 0x00, 0x00})
+	fmt.Println(err)
+
+	msg, err := ipfix.DecodeMessage([]byte{0x00, 0x0a, 0x00, 0x18, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x00, 0x02, 0x00, 0x08, 0x01, 0x00, 0x00, 0x00})
+	if err != nil {
+		fmt.Println("DecodeMessage failed:", err)
+		return
+	}
+	set := msg.Sets[0].(ipfix.TemplateSet)
+	fmt.Println(set.Records[0].TemplateID, len(set.Records[0].Elements))
+
+	_, err = ipfix.DecodeMessage([]byte{0x00, 0x0a, 0x00, 0x18, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x00, 0x02, 0x00, 0x10, 0x01, 0x00, 0x00, 0x00})
+	fmt.Println(err)
+	// Output:
+	// ipfix: Unsupported message version 9
+	// 256 0
+	// ipfix: Malformed set with id 2: illegal set length
+}
+
+func ExampleTemplateCache() {
+	// buf holds the ipfix stream that will be read
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC) // simulated fixed time
+
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 5)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+	b, _ := ipfix.GetInformationElement("sourceIPv4Address")
+	id, err := msgStream.AddTemplate(now, a, b.Reverse())
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+	msgStream.Flush(now)
+
+	msg, err := ipfix.MakeMessageReader(buf).ReadMessage()
+	if err != nil {
+		fmt.Println("MessageReader.ReadMessage failed:", err)
+		return
+	}
+
+	// The transport session can be any comparable value; here we only have a single session
+	session := "example"
+	cache := ipfix.MakeTemplateCache()
+
+	// Data sets arriving before their template can't be decoded
+	_, err = cache.Lookup(session, msg.ObservationDomainID, uint16(id))
+	fmt.Println(err)
+
+	cache.Update(session, msg)
+	elements, err := cache.Lookup(session, msg.ObservationDomainID, uint16(id))
+	if err != nil {
+		fmt.Println("TemplateCache.Lookup failed:", err)
+		return
+	}
+	fmt.Println(elements)
+
+	// Templates are scoped by observation domain
+	_, err = cache.Lookup(session, 6, uint16(id))
+	fmt.Println(err)
+	// Output:
+	// ipfix: Template id 256 unknown
+	// [octetDeltaCount reverseSourceIPv4Address(29305/8)<ipv4Address>]
+	// ipfix: Template id 256 unknown
+}
+
+func ExampleTemplateCache_DecodeDataSet() {
+	// buf holds the ipfix stream that will be read
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC) // simulated fixed time
+
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+	a, _ := ipfix.GetInformationElement("sourceIPv4Address")
+	b, _ := ipfix.GetInformationElement("flowEndNanoseconds")
+	c, _ := ipfix.GetInformationElement("applicationName")
+	id, err := msgStream.AddTemplate(now, a, b, ipfix.NewBasicList("testlist", c, 0))
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+	msgStream.SendData(now, id, net.IP{192, 168, 0, 1}, now, []string{"testA", "testB"})
+	msgStream.SendData(now, id, net.IP{192, 168, 0, 2}, now.Add(time.Second), []string{})
+	msgStream.Flush(now)
+
+	msg, err := ipfix.MakeMessageReader(buf).ReadMessage()
+	if err != nil {
+		fmt.Println("MessageReader.ReadMessage failed:", err)
+		return
+	}
+	cache := ipfix.MakeTemplateCache()
+	cache.Update(nil, msg)
+	for _, set := range msg.Sets {
+		if set, ok := set.(ipfix.DataSet); ok {
+			records, err := cache.DecodeDataSet(nil, msg.ObservationDomainID, set)
+			if err != nil {
+				fmt.Println("TemplateCache.DecodeDataSet failed:", err)
+				return
+			}
+			for _, record := range records {
+				fields := make([]string, len(record.Elements))
+				for i, element := range record.Elements {
+					fields[i] = fmt.Sprintf("%s=%v", element.Name, record.Values[i])
+				}
+				fmt.Println(strings.Join(fields, " "))
+			}
+		}
+	}
+	// Output:
+	// sourceIPv4Address=192.168.0.1 flowEndNanoseconds=2018-01-01 00:00:00 +0000 UTC basicList=[testA testB]
+	// sourceIPv4Address=192.168.0.2 flowEndNanoseconds=2018-01-01 00:00:01 +0000 UTC basicList=[]
+}
+
+func TestDecodeTemplateFieldCount(t *testing.T) {
+	// a template record with 65535 fields but without field specifiers
+	_, err := ipfix.DecodeMessage([]byte{0x00, 0x0a, 0x00, 0x18, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x00, 0x02, 0x00, 0x08, 0x01, 0x00, 0xff, 0xff})
+	if _, ok := err.(ipfix.MalformedSetError); !ok {
+		t.Errorf("expected MalformedSetError, got %v", err)
+	}
+}
+
+func TestDecodeDataSetPadding(t *testing.T) {
+	ipfix.LoadIANASpec()
+	buf := new(bytes.Buffer)
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, _ := ipfix.GetInformationElement("applicationName")
+	id, _ := msgStream.AddTemplate(0, name)
+	msgStream.SendData(0, id, "test")
+	msgStream.Flush(0)
+	msg, err := ipfix.DecodeMessage(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := ipfix.MakeTemplateCache()
+	cache.Update(nil, msg)
+
+	// padding to a multiple of 4 bytes must not be decoded as empty records
+	set := msg.Sets[len(msg.Sets)-1].(ipfix.DataSet)
+	set.Data = append(set.Data, 0, 0, 0)
+	records, err := cache.DecodeDataSet(nil, 0, set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Values[0] != "test" {
+		t.Errorf("expected a single record, got %v", records)
+	}
+}
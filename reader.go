@@ -0,0 +1,280 @@
+package ipfix
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// ipfixVersion is the version number of ipfix messages according to RFC7011 section 3.1
+const ipfixVersion = 0x0a
+
+// messageHeaderLength is the length of an ipfix message header
+const messageHeaderLength = 16
+
+// MessageHeader holds the header fields of an ipfix message according to RFC7011 section 3.1.
+type MessageHeader struct {
+	// Length is the total length of the message including the header
+	Length uint16
+	// ExportTime is the time at which the message left the exporter
+	ExportTime time.Time
+	// SequenceNumber is the number of data records sent in this session before this message
+	SequenceNumber uint32
+	// ObservationDomainID identifies the observation domain the data belongs to
+	ObservationDomainID uint32
+}
+
+// Message is a decoded ipfix message consisting of a header and a list of sets.
+type Message struct {
+	MessageHeader
+	// Sets holds the contained sets in the order they appeared in the message. Every entry is one of
+	// TemplateSet, OptionsTemplateSet or DataSet.
+	Sets []Set
+}
+
+// Set is a decoded template set, options template set, or data set.
+type Set interface {
+	// SetID returns the set id as written in the set header
+	SetID() uint16
+}
+
+// TemplateRecord is a decoded template record. The Elements only hold the values present in the field
+// specifier (Pen, ID and Length); Name is empty and Type is IllegalType. An empty Elements list
+// denotes a template withdrawal according to RFC7011 section 8.1.
+type TemplateRecord struct {
+	TemplateID uint16
+	Elements   []InformationElement
+}
+
+// OptionsTemplateRecord is a decoded options template record. The first ScopeFieldCount Elements are
+// the scope fields. Elements are filled in the same way as for TemplateRecord.
+type OptionsTemplateRecord struct {
+	TemplateID      uint16
+	ScopeFieldCount uint16
+	Elements        []InformationElement
+}
+
+// TemplateSet holds the template records of a template set.
+type TemplateSet struct {
+	Records []TemplateRecord
+}
+
+// SetID returns the set id of template sets.
+func (TemplateSet) SetID() uint16 {
+	return uint16(templateSetID)
+}
+
+// OptionsTemplateSet holds the options template records of an options template set.
+type OptionsTemplateSet struct {
+	Records []OptionsTemplateRecord
+}
+
+// SetID returns the set id of options template sets.
+func (OptionsTemplateSet) SetID() uint16 {
+	return uint16(optionsTemplateSetID)
+}
+
+// DataSet holds the undecoded content of a data set. The records in Data can only be split and
+// interpreted with the template referenced by TemplateID. Data might contain trailing padding.
+type DataSet struct {
+	TemplateID uint16
+	Data       []byte
+}
+
+// SetID returns the template id of the data set.
+func (d DataSet) SetID() uint16 {
+	return d.TemplateID
+}
+
+// MessageReader reads ipfix messages from an io.Reader, e.g., a stream written by MessageStream.
+type MessageReader struct {
+	r      io.Reader
+	header [messageHeaderLength]byte
+}
+
+// MakeMessageReader returns a MessageReader, which reads consecutive messages from r.
+func MakeMessageReader(r io.Reader) *MessageReader {
+	return &MessageReader{r: r}
+}
+
+// ReadMessage reads and decodes the next message. io.EOF is returned if the reader is exhausted
+// at a message boundary, io.ErrUnexpectedEOF if a message was truncated.
+func (r *MessageReader) ReadMessage() (*Message, error) {
+	if _, err := io.ReadFull(r.r, r.header[:]); err != nil {
+		return nil, err
+	}
+	length, err := checkMessageHeader(r.header[:])
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, length)
+	copy(buf, r.header[:])
+	if _, err := io.ReadFull(r.r, buf[messageHeaderLength:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return decodeMessage(buf)
+}
+
+// DecodeMessage decodes the message in b, which must contain exactly one complete message, like
+// the payload of an ipfix UDP datagram. The returned data sets reference b.
+func DecodeMessage(b []byte) (*Message, error) {
+	length, err := checkMessageHeader(b)
+	if err != nil {
+		return nil, err
+	}
+	if length != len(b) {
+		return nil, MessageLengthError{length, len(b)}
+	}
+	return decodeMessage(b)
+}
+
+// checkMessageHeader validates the version and length of the message header in b and returns the
+// message length.
+func checkMessageHeader(b []byte) (int, error) {
+	if len(b) < messageHeaderLength {
+		return 0, MessageLengthError{messageHeaderLength, len(b)}
+	}
+	if version := binary.BigEndian.Uint16(b[0:2]); version != ipfixVersion {
+		return 0, VersionError(version)
+	}
+	length := int(binary.BigEndian.Uint16(b[2:4]))
+	if length < messageHeaderLength {
+		return 0, MessageLengthError{length, messageHeaderLength}
+	}
+	return length, nil
+}
+
+func decodeMessage(b []byte) (*Message, error) {
+	_ = b[15]
+	msg := &Message{
+		MessageHeader: MessageHeader{
+			Length:              binary.BigEndian.Uint16(b[2:4]),
+			ExportTime:          time.Unix(int64(binary.BigEndian.Uint32(b[4:8])), 0).UTC(),
+			SequenceNumber:      binary.BigEndian.Uint32(b[8:12]),
+			ObservationDomainID: binary.BigEndian.Uint32(b[12:16]),
+		},
+	}
+	b = b[messageHeaderLength:]
+	for len(b) > 0 {
+		if len(b) < 4 {
+			return nil, MalformedSetError{0, "truncated set header"}
+		}
+		id := binary.BigEndian.Uint16(b[0:2])
+		length := int(binary.BigEndian.Uint16(b[2:4]))
+		if length < 4 || length > len(b) {
+			return nil, MalformedSetError{id, "illegal set length"}
+		}
+		content := b[4:length]
+		b = b[length:]
+		var s Set
+		var err error
+		switch {
+		case id == uint16(templateSetID):
+			s, err = decodeTemplateSet(content)
+		case id == uint16(optionsTemplateSetID):
+			s, err = decodeOptionsTemplateSet(content)
+		case id >= 256:
+			s = DataSet{TemplateID: id, Data: content}
+		default:
+			err = MalformedSetError{id, "reserved set id"}
+		}
+		if err != nil {
+			return nil, err
+		}
+		msg.Sets = append(msg.Sets, s)
+	}
+	return msg, nil
+}
+
+// decodeFieldSpecifiers decodes count field specifiers from the beginning of b and returns them
+// together with the rest of b.
+func decodeFieldSpecifiers(b []byte, count int, set uint16) ([]InformationElement, []byte, error) {
+	// every field specifier needs at least 4 bytes; don't allocate for counts that can't fit
+	if count > len(b)/4 {
+		return nil, nil, MalformedSetError{set, "truncated field specifier"}
+	}
+	elements := make([]InformationElement, count)
+	for i := range elements {
+		if len(b) < 4 {
+			return nil, nil, MalformedSetError{set, "truncated field specifier"}
+		}
+		ie := InformationElement{
+			ID:     binary.BigEndian.Uint16(b[0:2]),
+			Length: binary.BigEndian.Uint16(b[2:4]),
+			Type:   IllegalType,
+		}
+		b = b[4:]
+		if ie.ID&0x8000 != 0 {
+			if len(b) < 4 {
+				return nil, nil, MalformedSetError{set, "truncated enterprise number"}
+			}
+			ie.ID &= 0x7fff
+			ie.Pen = binary.BigEndian.Uint32(b[0:4])
+			b = b[4:]
+		}
+		elements[i] = ie
+	}
+	return elements, b, nil
+}
+
+func decodeTemplateSet(b []byte) (TemplateSet, error) {
+	var ret TemplateSet
+	// anything shorter than a template record header is padding
+	for len(b) >= 4 {
+		id := binary.BigEndian.Uint16(b[0:2])
+		count := int(binary.BigEndian.Uint16(b[2:4]))
+		b = b[4:]
+		if id < 256 && !(id == uint16(templateSetID) && count == 0) {
+			return TemplateSet{}, MalformedSetError{uint16(templateSetID), "illegal template id"}
+		}
+		elements, rest, err := decodeFieldSpecifiers(b, count, uint16(templateSetID))
+		if err != nil {
+			return TemplateSet{}, err
+		}
+		if count == 0 {
+			elements = nil
+		}
+		b = rest
+		ret.Records = append(ret.Records, TemplateRecord{id, elements})
+	}
+	return ret, nil
+}
+
+func decodeOptionsTemplateSet(b []byte) (OptionsTemplateSet, error) {
+	var ret OptionsTemplateSet
+	// anything shorter than a template withdrawal record is padding
+	for len(b) >= 4 {
+		id := binary.BigEndian.Uint16(b[0:2])
+		count := int(binary.BigEndian.Uint16(b[2:4]))
+		b = b[4:]
+		if count == 0 {
+			// withdrawal records have no scope field count
+			if id < 256 && id != uint16(optionsTemplateSetID) {
+				return OptionsTemplateSet{}, MalformedSetError{uint16(optionsTemplateSetID), "illegal template id"}
+			}
+			ret.Records = append(ret.Records, OptionsTemplateRecord{TemplateID: id})
+			continue
+		}
+		if id < 256 {
+			return OptionsTemplateSet{}, MalformedSetError{uint16(optionsTemplateSetID), "illegal template id"}
+		}
+		if len(b) < 2 {
+			return OptionsTemplateSet{}, MalformedSetError{uint16(optionsTemplateSetID), "truncated options template record"}
+		}
+		scope := binary.BigEndian.Uint16(b[0:2])
+		b = b[2:]
+		if scope == 0 || int(scope) > count {
+			return OptionsTemplateSet{}, MalformedSetError{uint16(optionsTemplateSetID), "illegal scope field count"}
+		}
+		elements, rest, err := decodeFieldSpecifiers(b, count, uint16(optionsTemplateSetID))
+		if err != nil {
+			return OptionsTemplateSet{}, err
+		}
+		b = rest
+		ret.Records = append(ret.Records, OptionsTemplateRecord{id, scope, elements})
+	}
+	return ret, nil
+}
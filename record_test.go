@@ -0,0 +1,249 @@
+package ipfix_test
+
+// GENERATED BY generate_record; DO NOT CHANGE!
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+
+	"github.com/CN-TU/go-ipfix"
+)
+
+var testRecordElements = []ipfix.InformationElement{
+	ipfix.NewInformationElement("sourceIPv4Address", 0, 8, ipfix.Ipv4AddressType, 4),
+	ipfix.NewInformationElement("destinationIPv6Address", 0, 28, ipfix.Ipv6AddressType, 16),
+	ipfix.NewInformationElement("octetDeltaCount", 0, 1, ipfix.Unsigned64Type, 8),
+	ipfix.NewInformationElement("packetDeltaCount", 0, 2, ipfix.Unsigned64Type, 4),
+	ipfix.NewInformationElement("flowStartSeconds", 0, 150, ipfix.DateTimeSecondsType, 4),
+	ipfix.NewInformationElement("flowStartMilliseconds", 0, 152, ipfix.DateTimeMillisecondsType, 8),
+	ipfix.NewInformationElement("flowEndMicroseconds", 0, 155, ipfix.DateTimeMicrosecondsType, 8),
+	ipfix.NewInformationElement("flowEndNanoseconds", 0, 157, ipfix.DateTimeNanosecondsType, 8),
+	ipfix.NewInformationElement("samplingProbability", 0, 311, ipfix.Float64Type, 8),
+	ipfix.NewInformationElement("samplingProbability2", 0, 311, ipfix.Float64Type, 4),
+	ipfix.NewInformationElement("applicationName", 0, 96, ipfix.StringType, ipfix.VariableLength),
+	ipfix.NewInformationElement("interfaceName", 0, 82, ipfix.StringType, 8),
+	ipfix.NewInformationElement("sourceMacAddress", 0, 56, ipfix.MacAddressType, 6),
+	ipfix.NewInformationElement("ipClassOfService", 0, 5, ipfix.Unsigned8Type, 1),
+	ipfix.NewInformationElement("mplsTopLabelTTL", 0, 200, ipfix.Unsigned8Type, 1),
+	ipfix.NewInformationElement("testSigned", 35566, 1, ipfix.Signed32Type, 3),
+	ipfix.NewInformationElement("testBool", 35566, 2, ipfix.BooleanType, 1),
+	ipfix.NewInformationElement("testOctets", 35566, 3, ipfix.OctetArrayType, 4),
+	ipfix.NewInformationElement("testFloat", 35566, 4, ipfix.Float32Type, 4),
+	ipfix.NewInformationElement("testVarOctets", 35566, 5, ipfix.OctetArrayType, ipfix.VariableLength),
+}
+
+// testRecord is a data record with the information elements sourceIPv4Address, destinationIPv6Address, octetDeltaCount, packetDeltaCount, flowStartSeconds, flowStartMilliseconds, flowEndMicroseconds, flowEndNanoseconds, samplingProbability, samplingProbability2, applicationName, interfaceName, sourceMacAddress, ipClassOfService, mplsTopLabelTTL, testSigned, testBool, testOctets, testFloat, testVarOctets.
+type testRecord struct {
+	SourceIPv4Address      [4]byte
+	DestinationIPv6Address [16]byte
+	OctetDeltaCount        uint64
+	PacketDeltaCount       uint32
+	FlowStartSeconds       time.Time
+	FlowStartMilliseconds  time.Time
+	FlowEndMicroseconds    time.Time
+	FlowEndNanoseconds     time.Time
+	SamplingProbability    float64
+	SamplingProbability2   float32
+	ApplicationName        string
+	InterfaceName          string
+	SourceMacAddress       [6]byte
+	IpClassOfService       uint8
+	MplsTopLabelTTL        uint8
+	TestSigned             int32
+	TestBool               bool
+	TestOctets             [4]byte
+	TestFloat              float32
+	TestVarOctets          []byte
+}
+
+// Elements returns the information elements of testRecord in template order.
+func (*testRecord) Elements() []ipfix.InformationElement {
+	return testRecordElements
+}
+
+// Encode writes the record to b and returns the number of written bytes. If b is too short,
+// io.ErrShortBuffer is returned.
+func (r *testRecord) Encode(b []byte) (int, error) {
+	if len(b) < 102 {
+		return 0, io.ErrShortBuffer
+	}
+	o := 0
+	copy(b[o:o+4], r.SourceIPv4Address[:])
+	o += 4
+	copy(b[o:o+16], r.DestinationIPv6Address[:])
+	o += 16
+	binary.BigEndian.PutUint64(b[o:], r.OctetDeltaCount)
+	o += 8
+	binary.BigEndian.PutUint32(b[o:], r.PacketDeltaCount)
+	o += 4
+	binary.BigEndian.PutUint32(b[o:], uint32(r.FlowStartSeconds.Unix()))
+	o += 4
+	binary.BigEndian.PutUint64(b[o:], uint64(r.FlowStartMilliseconds.Unix())*1e3+uint64(r.FlowStartMilliseconds.Nanosecond())/1e6)
+	o += 8
+	binary.BigEndian.PutUint32(b[o:], uint32(r.FlowEndMicroseconds.Unix())+0x83AA7E80)
+	binary.BigEndian.PutUint32(b[o+4:], uint32((uint64(r.FlowEndMicroseconds.Nanosecond())<<32)/1e9)&0xFFFFF800)
+	o += 8
+	binary.BigEndian.PutUint32(b[o:], uint32(r.FlowEndNanoseconds.Unix())+0x83AA7E80)
+	binary.BigEndian.PutUint32(b[o+4:], uint32((uint64(r.FlowEndNanoseconds.Nanosecond())<<32)/1e9)+1)
+	o += 8
+	binary.BigEndian.PutUint64(b[o:], math.Float64bits(r.SamplingProbability))
+	o += 8
+	binary.BigEndian.PutUint32(b[o:], math.Float32bits(r.SamplingProbability2))
+	o += 4
+	if l := len(r.ApplicationName); l < 255 {
+		if len(b)-o < 1+l+29 {
+			return 0, io.ErrShortBuffer
+		}
+		b[o] = byte(l)
+		o++
+	} else {
+		if l > 65535 || len(b)-o < 3+l+29 {
+			return 0, io.ErrShortBuffer
+		}
+		b[o] = 0xff
+		binary.BigEndian.PutUint16(b[o+1:], uint16(l))
+		o += 3
+	}
+	o += copy(b[o:], r.ApplicationName)
+	for i := o + copy(b[o:o+8], r.InterfaceName); i < o+8; i++ {
+		b[i] = 0
+	}
+	o += 8
+	copy(b[o:o+6], r.SourceMacAddress[:])
+	o += 6
+	b[o] = r.IpClassOfService
+	o++
+	b[o] = r.MplsTopLabelTTL
+	o++
+	if r.TestSigned < -1<<23 || r.TestSigned >= 1<<23 {
+		return 0, testRecordElements[15].CheckValue(r.TestSigned)
+	}
+	b[o+0] = byte(r.TestSigned >> 16)
+	b[o+1] = byte(r.TestSigned >> 8)
+	b[o+2] = byte(r.TestSigned)
+	o += 3
+	if r.TestBool {
+		b[o] = 1
+	} else {
+		b[o] = 2
+	}
+	o++
+	copy(b[o:o+4], r.TestOctets[:])
+	o += 4
+	binary.BigEndian.PutUint32(b[o:], math.Float32bits(r.TestFloat))
+	o += 4
+	if l := len(r.TestVarOctets); l < 255 {
+		if len(b)-o < 1+l+0 {
+			return 0, io.ErrShortBuffer
+		}
+		b[o] = byte(l)
+		o++
+	} else {
+		if l > 65535 || len(b)-o < 3+l+0 {
+			return 0, io.ErrShortBuffer
+		}
+		b[o] = 0xff
+		binary.BigEndian.PutUint16(b[o+1:], uint16(l))
+		o += 3
+	}
+	o += copy(b[o:], r.TestVarOctets)
+	return o, nil
+}
+
+// Decode reads the record from the start of b and returns the number of consumed bytes. If b is
+// too short, io.ErrUnexpectedEOF is returned.
+func (r *testRecord) Decode(b []byte) (int, error) {
+	if len(b) < 102 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	o := 0
+	copy(r.SourceIPv4Address[:], b[o:o+4])
+	o += 4
+	copy(r.DestinationIPv6Address[:], b[o:o+16])
+	o += 16
+	r.OctetDeltaCount = binary.BigEndian.Uint64(b[o:])
+	o += 8
+	r.PacketDeltaCount = binary.BigEndian.Uint32(b[o:])
+	o += 4
+	r.FlowStartSeconds = time.Unix(int64(binary.BigEndian.Uint32(b[o:])), 0).UTC()
+	o += 4
+	{
+		ms := binary.BigEndian.Uint64(b[o:])
+		r.FlowStartMilliseconds = time.Unix(int64(ms/1e3), int64(ms%1e3)*1e6).UTC()
+	}
+	o += 8
+	{
+		ns := (uint64(binary.BigEndian.Uint32(b[o+4:])) * 1e9) >> 32
+		ns = (ns + 500) / 1e3 * 1e3
+		r.FlowEndMicroseconds = time.Unix(int64(binary.BigEndian.Uint32(b[o:])-0x83AA7E80), int64(ns)).UTC()
+	}
+	o += 8
+	{
+		ns := (uint64(binary.BigEndian.Uint32(b[o+4:])) * 1e9) >> 32
+		r.FlowEndNanoseconds = time.Unix(int64(binary.BigEndian.Uint32(b[o:])-0x83AA7E80), int64(ns)).UTC()
+	}
+	o += 8
+	r.SamplingProbability = math.Float64frombits(binary.BigEndian.Uint64(b[o:]))
+	o += 8
+	r.SamplingProbability2 = math.Float32frombits(binary.BigEndian.Uint32(b[o:]))
+	o += 4
+	{
+		l := int(b[o])
+		o++
+		if l == 255 {
+			if len(b)-o < 2 {
+				return 0, io.ErrUnexpectedEOF
+			}
+			l = int(binary.BigEndian.Uint16(b[o:]))
+			o += 2
+		}
+		if len(b)-o < l+29 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		r.ApplicationName = string(b[o : o+l])
+		o += l
+	}
+	r.InterfaceName = string(b[o : o+8])
+	o += 8
+	copy(r.SourceMacAddress[:], b[o:o+6])
+	o += 6
+	r.IpClassOfService = b[o]
+	o++
+	r.MplsTopLabelTTL = b[o]
+	o++
+	r.TestSigned = int32(int64((uint64(b[o+0])<<16|uint64(b[o+1])<<8|uint64(b[o+2]))<<40) >> 40)
+	o += 3
+	switch b[o] {
+	case 1:
+		r.TestBool = true
+	case 2:
+		r.TestBool = false
+	default:
+		_, err := ipfix.BooleanType.Decode(b[o:o+1], 1)
+		return 0, err
+	}
+	o++
+	copy(r.TestOctets[:], b[o:o+4])
+	o += 4
+	r.TestFloat = math.Float32frombits(binary.BigEndian.Uint32(b[o:]))
+	o += 4
+	{
+		l := int(b[o])
+		o++
+		if l == 255 {
+			if len(b)-o < 2 {
+				return 0, io.ErrUnexpectedEOF
+			}
+			l = int(binary.BigEndian.Uint16(b[o:]))
+			o += 2
+		}
+		if len(b)-o < l+0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		r.TestVarOctets = append(r.TestVarOctets[:0], b[o:o+l]...)
+		o += l
+	}
+	return o, nil
+}
@@ -0,0 +1,165 @@
+package ipfix
+
+import "math"
+
+// ValueRange is an inclusive range of integer values. Min is only used by signed types and Max is limited
+// to math.MaxInt64 for signed types.
+type ValueRange struct {
+	Min int64
+	Max uint64
+}
+
+// integerRange returns the range of values of the integer type t encoded with length bytes.
+func integerRange(t Type, length int) ValueRange {
+	bits := uint(8 * length)
+	switch t {
+	case Signed8Type, Signed16Type, Signed32Type, Signed64Type:
+		return ValueRange{Min: -1 << (bits - 1), Max: 1<<(bits-1) - 1}
+	}
+	if bits >= 64 {
+		return ValueRange{Max: math.MaxUint64}
+	}
+	return ValueRange{Max: 1<<bits - 1}
+}
+
+// holds returns true if val as converted by integerValue is within r. val is sign-extended if signed is
+// true.
+func (r ValueRange) holds(val uint64, signed bool) bool {
+	if signed && int64(val) < 0 {
+		return int64(val) >= r.Min
+	}
+	return val <= r.Max
+}
+
+// isInteger returns true if t is an integer type that supports reduced-size encoding.
+func (t Type) isInteger() bool {
+	switch t {
+	case Unsigned8Type, Unsigned16Type, Unsigned32Type, Unsigned64Type, Signed8Type, Signed16Type, Signed32Type, Signed64Type:
+		return true
+	}
+	return false
+}
+
+// ReducedSizeFor returns ie with the smallest length according to RFC7011 section 6.2 that can hold all
+// the values in r. A RangeError is returned if the range exceeds the data type of ie. Information
+// elements that don't have an integer type are returned unchanged.
+func (ie InformationElement) ReducedSizeFor(r ValueRange) (InformationElement, error) {
+	if !ie.Type.isInteger() {
+		return ie, nil
+	}
+	size := int(DefaultSize[ie.Type])
+	full := integerRange(ie.Type, size)
+	switch {
+	case r.Min < full.Min || (full.Min == 0 && r.Min < 0):
+		return ie, RangeError{ie, r.Min, full.Min, full.Max}
+	case r.Max > full.Max:
+		return ie, RangeError{ie, r.Max, full.Min, full.Max}
+	}
+	for length := 1; length < size; length++ {
+		reduced := integerRange(ie.Type, length)
+		if r.Max <= reduced.Max && (full.Min == 0 || r.Min >= reduced.Min) {
+			ie.Length = uint16(length)
+			return ie, nil
+		}
+	}
+	ie.Length = uint16(size)
+	return ie, nil
+}
+
+// ReducedSize returns a copy of elements, where every integer and float64 information element has the
+// smallest length according to RFC7011 section 6.2 that can hold the corresponding values of all the
+// given data records. Float64 elements are only reduced if every value can be represented as float32
+// without loss. records are lists of values as passed to SendData. Values that can't be converted to the
+// type of the element result in a ConversionError.
+//
+// Values that exceed the chosen length at the time of SendData result in a RangeError.
+func ReducedSize(elements []InformationElement, records ...[]interface{}) ([]InformationElement, error) {
+	ret := make([]InformationElement, len(elements))
+	copy(ret, elements)
+	if len(records) == 0 {
+		return ret, nil
+	}
+	for _, record := range records {
+		if len(record) != len(elements) {
+			return nil, TemplateMismatchError{len(record), len(elements)}
+		}
+	}
+	for i, ie := range elements {
+		switch {
+		case ie.Type.isInteger():
+			r := ValueRange{Min: math.MaxInt64}
+			signed := integerRange(ie.Type, 1).Min < 0
+			for _, record := range records {
+				val, _, ok := integerValue(record[i])
+				if !ok {
+					return nil, ConversionError{ie.Type, record[i]}
+				}
+				if signed {
+					v := int64(val)
+					if v < r.Min {
+						r.Min = v
+					}
+					if v > 0 && uint64(v) > r.Max {
+						r.Max = uint64(v)
+					}
+				} else {
+					r.Min = 0
+					if val > r.Max {
+						r.Max = val
+					}
+				}
+			}
+			reduced, err := ie.ReducedSizeFor(r)
+			if err != nil {
+				return nil, err
+			}
+			ret[i] = reduced
+		case ie.Type == Float64Type:
+			exact := true
+			for _, record := range records {
+				val, ok := floatValue(record[i])
+				if !ok {
+					return nil, ConversionError{ie.Type, record[i]}
+				}
+				if float64(float32(val)) != val && !math.IsNaN(val) {
+					exact = false
+				}
+			}
+			if exact {
+				ret[i].Length = 4
+			} else {
+				ret[i].Length = 8
+			}
+		}
+	}
+	return ret, nil
+}
+
+// CheckValue returns a RangeError if the given value can't be encoded with the length of this integer or
+// float information element, or a ConversionError if the value can't be converted. Values of other types
+// are not checked.
+func (ie InformationElement) CheckValue(value interface{}) error {
+	length := int(ie.Length)
+	if length == 0 {
+		length = int(DefaultSize[ie.Type])
+	}
+	switch {
+	case ie.Type.isInteger():
+		val, signed, ok := integerValue(value)
+		if !ok {
+			return ConversionError{ie.Type, value}
+		}
+		if r := integerRange(ie.Type, length); !r.holds(val, signed) {
+			return RangeError{ie, value, r.Min, r.Max}
+		}
+	case ie.Type == Float32Type || ie.Type == Float64Type:
+		val, ok := floatValue(value)
+		if !ok {
+			return ConversionError{ie.Type, value}
+		}
+		if length == 4 && math.Abs(val) > math.MaxFloat32 && !math.IsInf(val, 0) {
+			return RangeError{ie, value, -math.MaxFloat32, math.MaxFloat32}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package ipfix
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestReducedSizeFor(t *testing.T) {
+	unsigned := NewInformationElement("u", 0, 1, Unsigned64Type, 0)
+	signed := NewInformationElement("s", 0, 2, Signed32Type, 0)
+	for _, test := range []struct {
+		ie     InformationElement
+		r      ValueRange
+		length uint16
+		err    bool
+	}{
+		{unsigned, ValueRange{Max: 0}, 1, false},
+		{unsigned, ValueRange{Max: 255}, 1, false},
+		{unsigned, ValueRange{Max: 256}, 2, false},
+		{unsigned, ValueRange{Max: 1 << 32}, 5, false},
+		{unsigned, ValueRange{Max: math.MaxUint64}, 8, false},
+		{unsigned, ValueRange{Min: -1}, 0, true},
+		{signed, ValueRange{Min: -128, Max: 127}, 1, false},
+		{signed, ValueRange{Min: -129, Max: 127}, 2, false},
+		{signed, ValueRange{Min: 0, Max: 128}, 2, false},
+		{signed, ValueRange{Min: -1 << 23, Max: 1<<23 - 1}, 3, false},
+		{signed, ValueRange{Min: -1 << 31, Max: 1<<31 - 1}, 4, false},
+		{signed, ValueRange{Max: 1 << 31}, 0, true},
+		{signed, ValueRange{Min: -1<<31 - 1}, 0, true},
+	} {
+		ie, err := test.ie.ReducedSizeFor(test.r)
+		if test.err {
+			if _, ok := err.(RangeError); !ok {
+				t.Errorf("%s %v: expected RangeError, got %v", test.ie.Name, test.r, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %v: %v", test.ie.Name, test.r, err)
+		} else if ie.Length != test.length {
+			t.Errorf("%s %v: got length %d, want %d", test.ie.Name, test.r, ie.Length, test.length)
+		}
+	}
+}
+
+func TestSerializeRange(t *testing.T) {
+	for _, test := range []struct {
+		t      Type
+		value  interface{}
+		length int
+		err    bool
+	}{
+		{Unsigned32Type, 255, 1, false},
+		{Unsigned32Type, 256, 1, true},
+		{Unsigned32Type, -1, 2, true},
+		{Unsigned32Type, -32768, 2, true},
+		{Unsigned32Type, uint64(1 << 16), 2, true},
+		{Unsigned32Type, -1, 4, true},
+		{Unsigned32Type, uint64(1 << 32), 4, true},
+		{Unsigned64Type, uint64(math.MaxUint64), 4, true},
+		{Unsigned64Type, -1, 8, false},
+		{Signed32Type, -128, 1, false},
+		{Signed32Type, -129, 1, true},
+		{Signed32Type, 128, 1, true},
+		{Signed32Type, int64(1 << 31), 4, true},
+		{Signed32Type, uint64(math.MaxUint64), 4, true},
+		{BooleanType, true, 1, false},
+		{Float64Type, math.MaxFloat64, 4, true},
+		{Float64Type, math.Inf(1), 4, false},
+		{Float64Type, math.MaxFloat64, 8, false},
+	} {
+		buffer := makeBasicBuffer(8)
+		_, err := test.t.serializeDataTo(buffer, test.value, test.length)
+		if _, ok := err.(RangeError); ok != test.err {
+			t.Errorf("%s[%d] %v: got error %v", test.t, test.length, test.value, err)
+		}
+	}
+}
+
+func TestUnsigned256Range(t *testing.T) {
+	max := new(big.Int).Lsh(big.NewInt(1), 256)
+	for _, test := range []struct {
+		value  interface{}
+		length int
+	}{
+		{max, 0},
+		{big.NewInt(-1), 0},
+		{big.NewInt(256), 1},
+		{[32]byte{0: 1}, 31},
+		{[]byte{1, 0, 0}, 2},
+		{append([]byte{1}, make([]byte, 32)...), 0},
+		{0x10000, 2},
+	} {
+		buffer := makeBasicBuffer(64)
+		_, err := Unsigned256Type.serializeDataTo(buffer, test.value, test.length)
+		if _, ok := err.(RangeError); !ok {
+			t.Errorf("%v with length %d: expected RangeError, got %v", test.value, test.length, err)
+		} else if buffer.length() != 0 {
+			t.Errorf("%v with length %d: RangeError after writing %d bytes", test.value, test.length, buffer.length())
+		}
+	}
+}
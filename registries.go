@@ -0,0 +1,20 @@
+package ipfix
+
+// ValueName returns the iana name of value for information elements with a value registry, like
+// natEvent or forwardingStatus. Reverse information elements use the registry of their forward
+// element. value can be any integer or one of the generated registry types.
+func (ie InformationElement) ValueName(value interface{}) (string, bool) {
+	if ie.Pen != 0 && ie.Pen != reversePen {
+		return "", false
+	}
+	names, ok := valueNames[ie.ID]
+	if !ok {
+		return "", false
+	}
+	val, _, ok := integerValue(value)
+	if !ok {
+		return "", false
+	}
+	name, ok := names[val]
+	return name, ok
+}
@@ -0,0 +1,365 @@
+package ipfix
+
+// GENERATED BY generate_registries; DO NOT CHANGE!
+
+import "strconv"
+
+// MplsLabelType holds the values of the information element mplsTopLabelType as assigned by the iana.
+type MplsLabelType uint8
+
+const (
+	// MplsLabelUnknown is "Unknown"
+	MplsLabelUnknown MplsLabelType = 0
+	// MplsLabelTEMIDPT is "TE-MIDPT"
+	MplsLabelTEMIDPT MplsLabelType = 1
+	// MplsLabelPseudowire is "Pseudowire"
+	MplsLabelPseudowire MplsLabelType = 2
+	// MplsLabelVPN is "VPN"
+	MplsLabelVPN MplsLabelType = 3
+	// MplsLabelBGP is "BGP"
+	MplsLabelBGP MplsLabelType = 4
+	// MplsLabelLDP is "LDP"
+	MplsLabelLDP MplsLabelType = 5
+)
+
+var mplsLabelTypeNames = map[uint64]string{
+	0: "Unknown",
+	1: "TE-MIDPT",
+	2: "Pseudowire",
+	3: "VPN",
+	4: "BGP",
+	5: "LDP",
+}
+
+func (v MplsLabelType) String() string {
+	if name, ok := mplsLabelTypeNames[uint64(v)]; ok {
+		return name
+	}
+	return "MplsLabelType(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// ForwardingStatus holds the values of the information element forwardingStatus as assigned by the iana.
+type ForwardingStatus uint8
+
+const (
+	// ForwardingStatusForwardedUnknown is "Forwarded: Unknown"
+	ForwardingStatusForwardedUnknown ForwardingStatus = 64
+	// ForwardingStatusForwardedFragmented is "Forwarded: Fragmented"
+	ForwardingStatusForwardedFragmented ForwardingStatus = 65
+	// ForwardingStatusForwardedNotFragmented is "Forwarded: Not Fragmented"
+	ForwardingStatusForwardedNotFragmented ForwardingStatus = 66
+	// ForwardingStatusForwardedTunneled is "Forwarded: Tunneled"
+	ForwardingStatusForwardedTunneled ForwardingStatus = 67
+	// ForwardingStatusDroppedUnknown is "Dropped: Unknown"
+	ForwardingStatusDroppedUnknown ForwardingStatus = 128
+	// ForwardingStatusDroppedACLDeny is "Dropped: ACL deny"
+	ForwardingStatusDroppedACLDeny ForwardingStatus = 129
+	// ForwardingStatusDroppedACLDrop is "Dropped: ACL drop"
+	ForwardingStatusDroppedACLDrop ForwardingStatus = 130
+	// ForwardingStatusDroppedUnroutable is "Dropped: Unroutable"
+	ForwardingStatusDroppedUnroutable ForwardingStatus = 131
+	// ForwardingStatusDroppedAdjacency is "Dropped: Adjacency"
+	ForwardingStatusDroppedAdjacency ForwardingStatus = 132
+	// ForwardingStatusDroppedFragmentationAndDFSet is "Dropped: Fragmentation and DF set"
+	ForwardingStatusDroppedFragmentationAndDFSet ForwardingStatus = 133
+	// ForwardingStatusDroppedBadHeaderChecksum is "Dropped: Bad header checksum"
+	ForwardingStatusDroppedBadHeaderChecksum ForwardingStatus = 134
+	// ForwardingStatusDroppedBadTotalLength is "Dropped: Bad total Length"
+	ForwardingStatusDroppedBadTotalLength ForwardingStatus = 135
+	// ForwardingStatusDroppedBadHeaderLength is "Dropped: Bad header length"
+	ForwardingStatusDroppedBadHeaderLength ForwardingStatus = 136
+	// ForwardingStatusDroppedBadTTL is "Dropped: bad TTL"
+	ForwardingStatusDroppedBadTTL ForwardingStatus = 137
+	// ForwardingStatusDroppedPolicer is "Dropped: Policer"
+	ForwardingStatusDroppedPolicer ForwardingStatus = 138
+	// ForwardingStatusDroppedWRED is "Dropped: WRED"
+	ForwardingStatusDroppedWRED ForwardingStatus = 139
+	// ForwardingStatusDroppedRPF is "Dropped: RPF"
+	ForwardingStatusDroppedRPF ForwardingStatus = 140
+	// ForwardingStatusDroppedForUs is "Dropped: For us"
+	ForwardingStatusDroppedForUs ForwardingStatus = 141
+	// ForwardingStatusDroppedBadOutputInterface is "Dropped: Bad output interface"
+	ForwardingStatusDroppedBadOutputInterface ForwardingStatus = 142
+	// ForwardingStatusDroppedHardware is "Dropped: Hardware"
+	ForwardingStatusDroppedHardware ForwardingStatus = 143
+	// ForwardingStatusConsumedUnknown is "Consumed: Unknown"
+	ForwardingStatusConsumedUnknown ForwardingStatus = 192
+	// ForwardingStatusConsumedPuntAdjacency is "Consumed: Punt Adjacency"
+	ForwardingStatusConsumedPuntAdjacency ForwardingStatus = 193
+	// ForwardingStatusConsumedIncompleteAdjacency is "Consumed: Incomplete Adjacency"
+	ForwardingStatusConsumedIncompleteAdjacency ForwardingStatus = 194
+	// ForwardingStatusConsumedForUs is "Consumed: For us"
+	ForwardingStatusConsumedForUs ForwardingStatus = 195
+)
+
+var forwardingStatusNames = map[uint64]string{
+	64:  "Forwarded: Unknown",
+	65:  "Forwarded: Fragmented",
+	66:  "Forwarded: Not Fragmented",
+	67:  "Forwarded: Tunneled",
+	128: "Dropped: Unknown",
+	129: "Dropped: ACL deny",
+	130: "Dropped: ACL drop",
+	131: "Dropped: Unroutable",
+	132: "Dropped: Adjacency",
+	133: "Dropped: Fragmentation and DF set",
+	134: "Dropped: Bad header checksum",
+	135: "Dropped: Bad total Length",
+	136: "Dropped: Bad header length",
+	137: "Dropped: bad TTL",
+	138: "Dropped: Policer",
+	139: "Dropped: WRED",
+	140: "Dropped: RPF",
+	141: "Dropped: For us",
+	142: "Dropped: Bad output interface",
+	143: "Dropped: Hardware",
+	192: "Consumed: Unknown",
+	193: "Consumed: Punt Adjacency",
+	194: "Consumed: Incomplete Adjacency",
+	195: "Consumed: For us",
+}
+
+func (v ForwardingStatus) String() string {
+	if name, ok := forwardingStatusNames[uint64(v)]; ok {
+		return name
+	}
+	return "ForwardingStatus(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// ClassificationEngineID holds the values of the information element classificationEngineId as assigned by the iana.
+type ClassificationEngineID uint8
+
+const (
+	// ClassificationEngineInvalid is "Invalid"
+	ClassificationEngineInvalid ClassificationEngineID = 0
+	// ClassificationEngineIANAL3 is "IANA-L3"
+	ClassificationEngineIANAL3 ClassificationEngineID = 1
+	// ClassificationEnginePANAL3 is "PANA-L3"
+	ClassificationEnginePANAL3 ClassificationEngineID = 2
+	// ClassificationEngineIANAL4 is "IANA-L4"
+	ClassificationEngineIANAL4 ClassificationEngineID = 3
+	// ClassificationEnginePANAL4 is "PANA-L4"
+	ClassificationEnginePANAL4 ClassificationEngineID = 4
+	// ClassificationEngineUSERDefined is "USER-Defined"
+	ClassificationEngineUSERDefined ClassificationEngineID = 6
+	// ClassificationEnginePANAL2 is "PANA-L2"
+	ClassificationEnginePANAL2 ClassificationEngineID = 12
+	// ClassificationEnginePANAL7 is "PANA-L7"
+	ClassificationEnginePANAL7 ClassificationEngineID = 13
+	// ClassificationEngineETHERTYPE is "ETHERTYPE"
+	ClassificationEngineETHERTYPE ClassificationEngineID = 18
+	// ClassificationEngineLLC is "LLC"
+	ClassificationEngineLLC ClassificationEngineID = 19
+	// ClassificationEnginePANAL7PEN is "PANA-L7-PEN"
+	ClassificationEnginePANAL7PEN ClassificationEngineID = 20
+	// ClassificationEngineQosmosIxEngine is "Qosmos ixEngine"
+	ClassificationEngineQosmosIxEngine ClassificationEngineID = 21
+)
+
+var classificationEngineIDNames = map[uint64]string{
+	0:  "Invalid",
+	1:  "IANA-L3",
+	2:  "PANA-L3",
+	3:  "IANA-L4",
+	4:  "PANA-L4",
+	6:  "USER-Defined",
+	12: "PANA-L2",
+	13: "PANA-L7",
+	18: "ETHERTYPE",
+	19: "LLC",
+	20: "PANA-L7-PEN",
+	21: "Qosmos ixEngine",
+}
+
+func (v ClassificationEngineID) String() string {
+	if name, ok := classificationEngineIDNames[uint64(v)]; ok {
+		return name
+	}
+	return "ClassificationEngineID(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// NatEvent holds the values of the information element natEvent as assigned by the iana.
+type NatEvent uint8
+
+const (
+	// NatEventNATTranslationCreate is "NAT translation create (Historic)"
+	NatEventNATTranslationCreate NatEvent = 1
+	// NatEventNATTranslationDelete is "NAT translation delete (Historic)"
+	NatEventNATTranslationDelete NatEvent = 2
+	// NatEventNATAddressesExhausted is "NAT Addresses exhausted"
+	NatEventNATAddressesExhausted NatEvent = 3
+	// NatEventNAT44SessionCreate is "NAT44 session create"
+	NatEventNAT44SessionCreate NatEvent = 4
+	// NatEventNAT44SessionDelete is "NAT44 session delete"
+	NatEventNAT44SessionDelete NatEvent = 5
+	// NatEventNAT64SessionCreate is "NAT64 session create"
+	NatEventNAT64SessionCreate NatEvent = 6
+	// NatEventNAT64SessionDelete is "NAT64 session delete"
+	NatEventNAT64SessionDelete NatEvent = 7
+	// NatEventNAT44BIBCreate is "NAT44 BIB create"
+	NatEventNAT44BIBCreate NatEvent = 8
+	// NatEventNAT44BIBDelete is "NAT44 BIB delete"
+	NatEventNAT44BIBDelete NatEvent = 9
+	// NatEventNAT64BIBCreate is "NAT64 BIB create"
+	NatEventNAT64BIBCreate NatEvent = 10
+	// NatEventNAT64BIBDelete is "NAT64 BIB delete"
+	NatEventNAT64BIBDelete NatEvent = 11
+	// NatEventNATPortsExhausted is "NAT ports exhausted"
+	NatEventNATPortsExhausted NatEvent = 12
+	// NatEventQuotaExceeded is "Quota Exceeded"
+	NatEventQuotaExceeded NatEvent = 13
+	// NatEventAddressBindingCreate is "Address binding create"
+	NatEventAddressBindingCreate NatEvent = 14
+	// NatEventAddressBindingDelete is "Address binding delete"
+	NatEventAddressBindingDelete NatEvent = 15
+	// NatEventPortBlockAllocation is "Port block allocation"
+	NatEventPortBlockAllocation NatEvent = 16
+	// NatEventPortBlockDeAllocation is "Port block de-allocation"
+	NatEventPortBlockDeAllocation NatEvent = 17
+	// NatEventThresholdReached is "Threshold Reached"
+	NatEventThresholdReached NatEvent = 18
+)
+
+var natEventNames = map[uint64]string{
+	1:  "NAT translation create (Historic)",
+	2:  "NAT translation delete (Historic)",
+	3:  "NAT Addresses exhausted",
+	4:  "NAT44 session create",
+	5:  "NAT44 session delete",
+	6:  "NAT64 session create",
+	7:  "NAT64 session delete",
+	8:  "NAT44 BIB create",
+	9:  "NAT44 BIB delete",
+	10: "NAT64 BIB create",
+	11: "NAT64 BIB delete",
+	12: "NAT ports exhausted",
+	13: "Quota Exceeded",
+	14: "Address binding create",
+	15: "Address binding delete",
+	16: "Port block allocation",
+	17: "Port block de-allocation",
+	18: "Threshold Reached",
+}
+
+func (v NatEvent) String() string {
+	if name, ok := natEventNames[uint64(v)]; ok {
+		return name
+	}
+	return "NatEvent(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// FlowSelectorAlgorithm holds the values of the information element flowSelectorAlgorithm as assigned by the iana.
+type FlowSelectorAlgorithm uint16
+
+const (
+	// FlowSelectorSystematicCountBasedSampling is "Systematic count-based Sampling"
+	FlowSelectorSystematicCountBasedSampling FlowSelectorAlgorithm = 1
+	// FlowSelectorSystematicTimeBasedSampling is "Systematic time-based Sampling"
+	FlowSelectorSystematicTimeBasedSampling FlowSelectorAlgorithm = 2
+	// FlowSelectorRandomNOutOfNSampling is "Random n-out-of-N Sampling"
+	FlowSelectorRandomNOutOfNSampling FlowSelectorAlgorithm = 3
+	// FlowSelectorUniformProbabilisticSampling is "Uniform probabilistic Sampling"
+	FlowSelectorUniformProbabilisticSampling FlowSelectorAlgorithm = 4
+	// FlowSelectorPropertyMatchFiltering is "Property Match Filtering"
+	FlowSelectorPropertyMatchFiltering FlowSelectorAlgorithm = 5
+	// FlowSelectorHashBasedFilteringUsingBOB is "Hash-based Filtering using BOB"
+	FlowSelectorHashBasedFilteringUsingBOB FlowSelectorAlgorithm = 6
+	// FlowSelectorHashBasedFilteringUsingIPSX is "Hash-based Filtering using IPSX"
+	FlowSelectorHashBasedFilteringUsingIPSX FlowSelectorAlgorithm = 7
+	// FlowSelectorHashBasedFilteringUsingCRC is "Hash-based Filtering using CRC"
+	FlowSelectorHashBasedFilteringUsingCRC FlowSelectorAlgorithm = 8
+	// FlowSelectorFlowStateDependentIntermediateFlowSelectionProcess is "Flow-state Dependent Intermediate Flow Selection Process"
+	FlowSelectorFlowStateDependentIntermediateFlowSelectionProcess FlowSelectorAlgorithm = 9
+)
+
+var flowSelectorAlgorithmNames = map[uint64]string{
+	1: "Systematic count-based Sampling",
+	2: "Systematic time-based Sampling",
+	3: "Random n-out-of-N Sampling",
+	4: "Uniform probabilistic Sampling",
+	5: "Property Match Filtering",
+	6: "Hash-based Filtering using BOB",
+	7: "Hash-based Filtering using IPSX",
+	8: "Hash-based Filtering using CRC",
+	9: "Flow-state Dependent Intermediate Flow Selection Process",
+}
+
+func (v FlowSelectorAlgorithm) String() string {
+	if name, ok := flowSelectorAlgorithmNames[uint64(v)]; ok {
+		return name
+	}
+	return "FlowSelectorAlgorithm(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// NatQuotaExceededEvent holds the values of the information element natQuotaExceededEvent as assigned by the iana.
+type NatQuotaExceededEvent uint32
+
+const (
+	// NatQuotaExceededMaximumSessionEntries is "Maximum session entries"
+	NatQuotaExceededMaximumSessionEntries NatQuotaExceededEvent = 1
+	// NatQuotaExceededMaximumBIBEntries is "Maximum BIB entries"
+	NatQuotaExceededMaximumBIBEntries NatQuotaExceededEvent = 2
+	// NatQuotaExceededMaximumEntriesPerUser is "Maximum entries per user"
+	NatQuotaExceededMaximumEntriesPerUser NatQuotaExceededEvent = 3
+	// NatQuotaExceededMaximumActiveHostsOrSubscribers is "Maximum active hosts or subscribers"
+	NatQuotaExceededMaximumActiveHostsOrSubscribers NatQuotaExceededEvent = 4
+	// NatQuotaExceededMaximumFragmentsPendingReassembly is "Maximum fragments pending reassembly"
+	NatQuotaExceededMaximumFragmentsPendingReassembly NatQuotaExceededEvent = 5
+)
+
+var natQuotaExceededEventNames = map[uint64]string{
+	1: "Maximum session entries",
+	2: "Maximum BIB entries",
+	3: "Maximum entries per user",
+	4: "Maximum active hosts or subscribers",
+	5: "Maximum fragments pending reassembly",
+}
+
+func (v NatQuotaExceededEvent) String() string {
+	if name, ok := natQuotaExceededEventNames[uint64(v)]; ok {
+		return name
+	}
+	return "NatQuotaExceededEvent(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// NatThresholdEvent holds the values of the information element natThresholdEvent as assigned by the iana.
+type NatThresholdEvent uint32
+
+const (
+	// NatThresholdAddressPoolHighThresholdEvent is "Address pool high threshold event"
+	NatThresholdAddressPoolHighThresholdEvent NatThresholdEvent = 1
+	// NatThresholdAddressPoolLowThresholdEvent is "Address pool low threshold event"
+	NatThresholdAddressPoolLowThresholdEvent NatThresholdEvent = 2
+	// NatThresholdAddressAndPortMappingHighThresholdEvent is "Address and port mapping high threshold event"
+	NatThresholdAddressAndPortMappingHighThresholdEvent NatThresholdEvent = 3
+	// NatThresholdAddressAndPortMappingPerUserHighThresholdEvent is "Address and port mapping per user high threshold event"
+	NatThresholdAddressAndPortMappingPerUserHighThresholdEvent NatThresholdEvent = 4
+	// NatThresholdGlobalAddressMappingHighThresholdEvent is "Global Address mapping high threshold event"
+	NatThresholdGlobalAddressMappingHighThresholdEvent NatThresholdEvent = 5
+)
+
+var natThresholdEventNames = map[uint64]string{
+	1: "Address pool high threshold event",
+	2: "Address pool low threshold event",
+	3: "Address and port mapping high threshold event",
+	4: "Address and port mapping per user high threshold event",
+	5: "Global Address mapping high threshold event",
+}
+
+func (v NatThresholdEvent) String() string {
+	if name, ok := natThresholdEventNames[uint64(v)]; ok {
+		return name
+	}
+	return "NatThresholdEvent(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// valueNames holds the names of the values of iana information elements with a value registry
+var valueNames = map[uint16]map[uint64]string{
+	46:  mplsLabelTypeNames,
+	89:  forwardingStatusNames,
+	101: classificationEngineIDNames,
+	230: natEventNames,
+	390: flowSelectorAlgorithmNames,
+	466: natQuotaExceededEventNames,
+	467: natThresholdEventNames,
+}
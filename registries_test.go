@@ -0,0 +1,100 @@
+package ipfix_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+func ExampleInformationElement_ValueName() {
+	// buf holds the ipfix stream that will be read
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+	a, _ := ipfix.GetInformationElement("natEvent")
+	b, _ := ipfix.GetInformationElement("forwardingStatus")
+	id, err := msgStream.AddTemplate(0, a, b)
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+	msgStream.SendData(0, id, ipfix.NatEventNAT44SessionCreate, ipfix.ForwardingStatusDroppedACLDeny)
+	msgStream.Flush(0)
+
+	msg, err := ipfix.MakeMessageReader(buf).ReadMessage()
+	if err != nil {
+		fmt.Println("MessageReader.ReadMessage failed:", err)
+		return
+	}
+	cache := ipfix.MakeTemplateCache()
+	cache.Update(nil, msg)
+	for _, set := range msg.Sets {
+		if set, ok := set.(ipfix.DataSet); ok {
+			records, err := cache.DecodeDataSet(nil, msg.ObservationDomainID, set)
+			if err != nil {
+				fmt.Println("TemplateCache.DecodeDataSet failed:", err)
+				return
+			}
+			for _, record := range records {
+				for i, element := range record.Elements {
+					name, _ := element.ValueName(record.Values[i])
+					fmt.Printf("%s=%v (%s)\n", element.Name, record.Values[i], name)
+				}
+			}
+		}
+	}
+	// Output:
+	// natEvent=4 (NAT44 session create)
+	// forwardingStatus=129 (Dropped: ACL deny)
+}
+
+func TestValueName(t *testing.T) {
+	ipfix.LoadIANASpec()
+	natEvent, _ := ipfix.GetInformationElement("natEvent")
+	octets, _ := ipfix.GetInformationElement("octetDeltaCount")
+	private := ipfix.NewInformationElement("natEvent", 1234, natEvent.ID, ipfix.Unsigned8Type, 0)
+	for _, test := range []struct {
+		ie    ipfix.InformationElement
+		value interface{}
+		name  string
+	}{
+		{natEvent, ipfix.NatEventQuotaExceeded, "Quota Exceeded"},
+		{natEvent, uint8(13), "Quota Exceeded"},
+		{natEvent, 13, "Quota Exceeded"},
+		{natEvent.Reverse(), uint8(13), "Quota Exceeded"},
+		{natEvent, uint8(200), ""},
+		{natEvent, "13", ""},
+		{octets, uint64(13), ""},
+		{private, uint8(13), ""},
+	} {
+		name, ok := test.ie.ValueName(test.value)
+		if name != test.name || ok != (test.name != "") {
+			t.Errorf("%s %v: expected %q, got %q (%v)", test.ie, test.value, test.name, name, ok)
+		}
+	}
+}
+
+func TestRegistryString(t *testing.T) {
+	for _, test := range []struct {
+		value fmt.Stringer
+		want  string
+	}{
+		{ipfix.MplsLabelLDP, "LDP"},
+		{ipfix.ClassificationEngineIANAL4, "IANA-L4"},
+		{ipfix.FlowSelectorHashBasedFilteringUsingCRC, "Hash-based Filtering using CRC"},
+		{ipfix.NatEvent(200), "NatEvent(200)"},
+	} {
+		if got := test.value.String(); got != test.want {
+			t.Errorf("expected %q, got %q", test.want, got)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package ipfix
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Registry holds information elements by name and by enterprise number and id. Registries can be
+// layered: lookups that are not found in a Registry continue in its parent, and information elements
+// registered in a Registry shadow the ones of its parent with the same name or id. This allows, e.g.,
+// an iana base registry, overlays with enterprise specific elements, and per-tenant overrides.
+//
+// Registry is safe for concurrent use.
+type Registry struct {
+	parent *Registry
+	mu     sync.RWMutex
+	names  map[string]InformationElement
+	ids    map[ieKey]InformationElement
+	// version counts the elements registered in r
+	version uint64
+	// structs caches the structMapping of every struct type used with AddStructTemplate or SendStruct
+	structs sync.Map
+}
+
+type ieKey struct {
+	pen uint32
+	id  uint16
+}
+
+// DefaultRegistry is the registry used by the package level functions like RegisterInformationElement,
+// GetInformationElement, and LoadIANASpec, and by TemplateCaches without a Registry.
+var DefaultRegistry = MakeRegistry(nil)
+
+// MakeRegistry returns a new and empty Registry layered on top of parent. parent can be nil.
+func MakeRegistry(parent *Registry) *Registry {
+	return &Registry{
+		parent: parent,
+		names:  make(map[string]InformationElement),
+		ids:    make(map[ieKey]InformationElement),
+	}
+}
+
+// Parent returns the registry r is layered on top of or nil.
+func (r *Registry) Parent() *Registry {
+	return r.parent
+}
+
+// Register registers the given InformationElement in r. An error is returned if the name is already
+// registered in r; names of the parent registries can be reused to override their elements. If several
+// elements with the same enterprise number and id are registered in r, lookups by id return the first
+// one.
+func (r *Registry) Register(x InformationElement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.names[x.Name]; ok {
+		return fmt.Errorf("ipfix: Information element with name '%s' already registered", x.Name)
+	}
+	r.register(x)
+	return nil
+}
+
+// register adds x to r, which must be locked, and replaces elements with the same name
+func (r *Registry) register(x InformationElement) {
+	r.version++
+	r.names[x.Name] = x
+	key := ieKey{x.Pen, x.ID}
+	if _, ok := r.ids[key]; !ok {
+		r.ids[key] = x
+	}
+}
+
+// versions returns the sum of the versions of r and its parents, which changes whenever an element is
+// registered in one of them.
+func (r *Registry) versions() uint64 {
+	var sum uint64
+	for l := r; l != nil; l = l.parent {
+		l.mu.RLock()
+		sum += l.version
+		l.mu.RUnlock()
+	}
+	return sum
+}
+
+// Get retrieves an InformationElement by name from r or its parents.
+func (r *Registry) Get(name string) (InformationElement, error) {
+	for l := r; l != nil; l = l.parent {
+		l.mu.RLock()
+		ie, ok := l.names[name]
+		l.mu.RUnlock()
+		if ok {
+			return ie, nil
+		}
+	}
+	return InformationElement{}, fmt.Errorf("ipfix: No information element with name '%s' registered", name)
+}
+
+// GetByID retrieves an InformationElement by enterprise number and id from r or its parents, e.g., from
+// the field specifier of a template. Reverse information elements according to RFC5103 are derived from
+// the forward element, and the list types of RFC6313 are known even if the iana information elements are
+// not loaded. For unknown information elements a placeholder without name of type octetArray and false is
+// returned.
+func (r *Registry) GetByID(pen uint32, id uint16) (InformationElement, bool) {
+	if ie, ok := r.lookup(pen, id); ok {
+		return ie, true
+	}
+	if pen == ianaPen {
+		switch id {
+		case basicListID:
+			return NewInformationElement("basicList", ianaPen, basicListID, BasicListType, 0), true
+		case subTemplateListID:
+			return NewInformationElement("subTemplateList", ianaPen, subTemplateListID, SubTemplateListType, 0), true
+		case subTemplateMultiListID:
+			return NewInformationElement("subTemplateMultiList", ianaPen, subTemplateMultiListID, SubTemplateMultiListType, 0), true
+		}
+	}
+	return NewInformationElement("", pen, id, OctetArrayType, 0), false
+}
+
+// lookup retrieves a registered InformationElement by enterprise number and id. Reverse information
+// elements according to RFC5103 are derived from the forward element.
+func (r *Registry) lookup(pen uint32, id uint16) (InformationElement, bool) {
+	if ie, ok := r.lookupKey(ieKey{pen, id}); ok {
+		return ie, true
+	}
+	if pen == reversePen {
+		if ie, ok := r.lookupKey(ieKey{ianaPen, id}); ok {
+			return ie.Reverse(), true
+		}
+	}
+	return InformationElement{}, false
+}
+
+func (r *Registry) lookupKey(key ieKey) (InformationElement, bool) {
+	for l := r; l != nil; l = l.parent {
+		l.mu.RLock()
+		ie, ok := l.ids[key]
+		l.mu.RUnlock()
+		if ok {
+			return ie, true
+		}
+	}
+	return InformationElement{}, false
+}
+
+// InformationElements returns all information elements of r and its parents sorted by enterprise number,
+// id, and name. Elements of parents that are shadowed by name are omitted.
+func (r *Registry) InformationElements() []InformationElement {
+	names := make(map[string]InformationElement)
+	for l := r; l != nil; l = l.parent {
+		l.mu.RLock()
+		for name, ie := range l.names {
+			if _, ok := names[name]; !ok {
+				names[name] = ie
+			}
+		}
+		l.mu.RUnlock()
+	}
+	ret := make([]InformationElement, 0, len(names))
+	for _, ie := range names {
+		ret = append(ret, ie)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Pen != ret[j].Pen {
+			return ret[i].Pen < ret[j].Pen
+		}
+		if ret[i].ID != ret[j].ID {
+			return ret[i].ID < ret[j].ID
+		}
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+// RegisterInformationElement registers the given InformationElement in the DefaultRegistry. This can
+// later be queried by name with GetInformationElement.
+func RegisterInformationElement(x InformationElement) error {
+	return DefaultRegistry.Register(x)
+}
+
+// GetInformationElement retrieves an InformationElement by name from the DefaultRegistry.
+func GetInformationElement(name string) (InformationElement, error) {
+	return DefaultRegistry.Get(name)
+}
+
+// GetInformationElementByID retrieves an InformationElement by enterprise number and id from the
+// DefaultRegistry as described at Registry.GetByID.
+func GetInformationElementByID(pen uint32, id uint16) (InformationElement, bool) {
+	return DefaultRegistry.GetByID(pen, id)
+}
+
+// InformationElements returns all information elements of the DefaultRegistry sorted by enterprise
+// number, id, and name.
+func InformationElements() []InformationElement {
+	return DefaultRegistry.InformationElements()
+}
@@ -0,0 +1,278 @@
+package ipfix_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/netip"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+func ExampleMakeRegistry() {
+	iana := ipfix.MakeRegistry(nil)
+	ipfix.LoadIANASpecInto(iana)
+
+	// enterprise specific elements on top of the iana elements
+	vendor := ipfix.MakeRegistry(iana)
+	vendor.Register(ipfix.NewInformationElement("vendorCounter", 12345, 1, ipfix.Unsigned64Type, 0))
+
+	// a tenant overriding an iana element
+	tenant := ipfix.MakeRegistry(vendor)
+	tenant.Register(ipfix.NewInformationElement("octetDeltaCount", 0, 1, ipfix.Unsigned32Type, 0))
+
+	for _, r := range []*ipfix.Registry{iana, vendor, tenant} {
+		counter, _ := r.GetByID(12345, 1)
+		octets, _ := r.Get("octetDeltaCount")
+		fmt.Printf("%q %s\n", counter.Name, octets.Type)
+	}
+	// Output:
+	// "" unsigned64
+	// "vendorCounter" unsigned64
+	// "vendorCounter" unsigned32
+}
+
+func TestRegistryLayers(t *testing.T) {
+	base := ipfix.MakeRegistry(nil)
+	overlay := ipfix.MakeRegistry(base)
+	a := ipfix.NewInformationElement("a", 1, 1, ipfix.Unsigned8Type, 0)
+	b := ipfix.NewInformationElement("b", 1, 2, ipfix.Unsigned8Type, 0)
+	shadow := ipfix.NewInformationElement("a", 1, 3, ipfix.Unsigned16Type, 0)
+	if err := base.Register(a); err != nil {
+		t.Fatal(err)
+	}
+	if err := base.Register(a); err == nil {
+		t.Error("expected error when registering a name twice")
+	}
+	if err := overlay.Register(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := overlay.Register(shadow); err != nil {
+		t.Error("names of the parent should be overridable:", err)
+	}
+	if overlay.Parent() != base || base.Parent() != nil {
+		t.Error("wrong parents")
+	}
+
+	if _, err := base.Get("b"); err == nil {
+		t.Error("elements of overlays must not be visible in the parent")
+	}
+	if ie, err := overlay.Get("a"); err != nil || ie.ID != 3 {
+		t.Errorf("expected shadowed a with id 3, got %v %v", ie, err)
+	}
+	if ie, ok := overlay.GetByID(1, 1); !ok || ie.Name != "a" || ie.Type != ipfix.Unsigned8Type {
+		t.Errorf("expected a from the parent, got %v %v", ie, ok)
+	}
+	var names []string
+	for _, ie := range overlay.InformationElements() {
+		names = append(names, fmt.Sprintf("%s/%d", ie.Name, ie.ID))
+	}
+	if fmt.Sprint(names) != "[b/2 a/3]" {
+		t.Errorf("expected [b/2 a/3], got %v", names)
+	}
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	r := ipfix.MakeRegistry(nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				r.Register(ipfix.NewInformationElement(fmt.Sprintf("e%d_%d", i, j), 1, uint16(i*100+j), ipfix.Unsigned8Type, 0))
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			ipfix.LoadIANASpec()
+			for j := 0; j < 100; j++ {
+				r.GetByID(1, uint16(j))
+				r.Get("e0_0")
+				if _, err := ipfix.GetInformationElement("octetDeltaCount"); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := len(r.InformationElements()); n != 800 {
+		t.Errorf("expected 800 elements, got %d", n)
+	}
+}
+
+func TestTemplateCacheRegistry(t *testing.T) {
+	counter := ipfix.NewInformationElement("vendorCounter", 12345, 1, ipfix.Unsigned32Type, 0)
+	buf := new(bytes.Buffer)
+	s, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := s.AddTemplate(0, counter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendData(0, id, uint32(42)); err != nil {
+		t.Fatal(err)
+	}
+	s.Flush(0)
+	msg, err := ipfix.DecodeMessage(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vendor := ipfix.MakeRegistry(nil)
+	vendor.Register(counter)
+	for _, test := range []struct {
+		registry *ipfix.Registry
+		name     string
+		value    interface{}
+	}{
+		{nil, "", []byte{0, 0, 0, 42}},
+		{vendor, "vendorCounter", uint64(42)},
+	} {
+		cache := ipfix.MakeTemplateCache()
+		cache.Registry = test.registry
+		cache.Update(nil, msg)
+		for _, set := range msg.Sets {
+			set, ok := set.(ipfix.DataSet)
+			if !ok {
+				continue
+			}
+			records, err := cache.DecodeDataSet(nil, msg.ObservationDomainID, set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if name, value := records[0].Elements[0].Name, records[0].Values[0]; name != test.name || fmt.Sprint(value) != fmt.Sprint(test.value) {
+				t.Errorf("expected %q=%v, got %q=%v", test.name, test.value, name, value)
+			}
+		}
+	}
+}
+
+func TestVendorSpecs(t *testing.T) {
+	all := ipfix.MakeRegistry(nil)
+	ipfix.LoadIANASpecInto(all)
+	for _, test := range []struct {
+		file string
+		load func(r *ipfix.Registry)
+	}{
+		{"spec_yaf.iespec", ipfix.LoadYAFSpecInto},
+		{"spec_ntop.iespec", ipfix.LoadNtopSpecInto},
+		{"spec_vmware.iespec", ipfix.LoadVMwareSpecInto},
+		{"spec_cisco_avc.iespec", ipfix.LoadCiscoAVCSpecInto},
+		{"spec_barracuda.iespec", ipfix.LoadBarracudaSpecInto},
+	} {
+		spec, err := os.ReadFile(test.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		elements, err := ipfix.ParseIESpecs(spec)
+		if err != nil {
+			t.Fatal(test.file, err)
+		}
+		generated := ipfix.MakeRegistry(nil)
+		test.load(generated)
+		if n := len(generated.InformationElements()); n != len(elements) {
+			t.Errorf("%s: expected %d generated elements, got %d", test.file, len(elements), n)
+		}
+		for _, ie := range elements {
+			// vendor dictionaries must be usable together with each other and the iana elements
+			if err := all.Register(ie); err != nil {
+				t.Errorf("%s: %v", test.file, err)
+			}
+			if found, ok := generated.GetByID(ie.Pen, ie.ID); !ok || !reflect.DeepEqual(found, ie) {
+				t.Errorf("%s: expected %v, got %v", test.file, ie, found)
+			}
+		}
+	}
+}
+
+func TestStructTemplateRegistry(t *testing.T) {
+	ipfix.LoadIANASpec()
+	tenant := ipfix.MakeRegistry(nil)
+	tenant.Register(ipfix.NewInformationElement("sourceIPv4Address", 0, 8, ipfix.Ipv4AddressType, 0))
+	tenant.Register(ipfix.NewInformationElement("tenantPrefixLength", 0, 9, ipfix.Unsigned8Type, 0))
+	tenant.Register(ipfix.NewInformationElement("tenantBytes", 12345, 1, ipfix.Unsigned64Type, 0))
+	type flow struct {
+		Source netip.Prefix `ipfix:"sourceIPv4Address"`
+		Bytes  uint64       `ipfix:"tenantBytes"`
+	}
+
+	defaults, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := defaults.AddStructTemplate(0, flow{}); err == nil {
+		t.Error("expected error for element that is only registered for the tenant")
+	}
+
+	buf := new(bytes.Buffer)
+	s, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Registry = tenant
+	id, err := s.AddStructTemplate(0, flow{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendStruct(0, id, flow{netip.MustParsePrefix("10.0.0.0/8"), 1}); err != nil {
+		t.Fatal(err)
+	}
+	s.Flush(0)
+
+	// the template holds the prefix length element of the tenant
+	msg, err := ipfix.DecodeMessage(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := ipfix.MakeTemplateCache()
+	cache.Registry = tenant
+	cache.Update(nil, msg)
+	elements, err := cache.Lookup(nil, 0, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(elements) != 3 || elements[1].Name != "tenantPrefixLength" {
+		t.Errorf("expected the prefix length of the tenant, got %v", elements)
+	}
+}
+
+func TestStructTemplateRegistryUpdate(t *testing.T) {
+	base := ipfix.MakeRegistry(nil)
+	tenant := ipfix.MakeRegistry(base)
+	type flow struct {
+		Bytes uint64 `ipfix:"updatedBytes"`
+	}
+	s, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Registry = tenant
+	if _, err := s.AddStructTemplate(0, flow{}); err == nil {
+		t.Fatal("expected error for unregistered element")
+	}
+
+	// elements registered in a parent after a failed or cached lookup are used
+	base.Register(ipfix.NewInformationElement("updatedBytes", 12345, 1, ipfix.Unsigned64Type, 0))
+	first, err := s.AddStructTemplate(0, flow{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tenant.Register(ipfix.NewInformationElement("updatedBytes", 12345, 2, ipfix.Unsigned64Type, 0))
+	second, err := s.AddStructTemplate(0, flow{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendStruct(0, first, flow{1}); err == nil {
+		t.Error("expected mismatch for template of the overridden element")
+	}
+	if err := s.SendStruct(0, second, flow{1}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,56 @@
+package ipfix
+
+// DataTypeSemantics represents the data type semantics of an information element according to RFC7012
+// section 3.2. The values match the iana "IPFIX Information Element Semantics" registry.
+type DataTypeSemantics byte
+
+const (
+	// DefaultSemantics as defined by RFC7012; no semantics were specified
+	DefaultSemantics DataTypeSemantics = iota
+	// QuantitySemantics as defined by RFC7012
+	QuantitySemantics
+	// TotalCounterSemantics as defined by RFC7012
+	TotalCounterSemantics
+	// DeltaCounterSemantics as defined by RFC7012
+	DeltaCounterSemantics
+	// IdentifierSemantics as defined by RFC7012
+	IdentifierSemantics
+	// FlagsSemantics as defined by RFC7012
+	FlagsSemantics
+	// ListSemantics as defined by RFC6313
+	ListSemantics
+	// SnmpCounterSemantics as defined by RFC8038
+	SnmpCounterSemantics
+	// SnmpGaugeSemantics as defined by RFC8038
+	SnmpGaugeSemantics
+)
+
+var semanticsNames = [...]string{
+	DefaultSemantics:      "default",
+	QuantitySemantics:     "quantity",
+	TotalCounterSemantics: "totalCounter",
+	DeltaCounterSemantics: "deltaCounter",
+	IdentifierSemantics:   "identifier",
+	FlagsSemantics:        "flags",
+	ListSemantics:         "list",
+	SnmpCounterSemantics:  "snmpCounter",
+	SnmpGaugeSemantics:    "snmpGauge",
+}
+
+func (s DataTypeSemantics) String() string {
+	if int(s) < len(semanticsNames) {
+		return semanticsNames[s]
+	}
+	return "unassigned"
+}
+
+// NameToSemantics converts the given textual representation of data type semantics. The second return
+// value is false if the semantics are not recognised.
+func NameToSemantics(x []byte) (DataTypeSemantics, bool) {
+	for i, name := range semanticsNames {
+		if name == string(x) {
+			return DataTypeSemantics(i), true
+		}
+	}
+	return DefaultSemantics, false
+}
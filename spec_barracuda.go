@@ -0,0 +1,31 @@
+package ipfix
+
+// GENERATED BY generate_spec; DO NOT CHANGE!
+
+import "sync"
+
+var onceLoadBarracudaSpec sync.Once
+
+// LoadBarracudaSpec loads information elements from the given specification into the DefaultRegistry.
+// It is safe to call LoadBarracudaSpec several times and concurrently.
+func LoadBarracudaSpec() {
+	onceLoadBarracudaSpec.Do(func() {
+		LoadBarracudaSpecInto(DefaultRegistry)
+	})
+}
+
+// LoadBarracudaSpecInto registers the information elements from the given specification in r
+func LoadBarracudaSpecInto(r *Registry) {
+	r.Register(InformationElement{Name: "timestamp", Pen: 0x29d0, ID: 0x1, Type: 14, Length: 0x4})
+	r.Register(InformationElement{Name: "logOp", Pen: 0x29d0, ID: 0x2, Type: 1, Length: 0x1, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "trafficType", Pen: 0x29d0, ID: 0x3, Type: 1, Length: 0x1, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "fwRule", Pen: 0x29d0, ID: 0x4, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "serviceName", Pen: 0x29d0, ID: 0x5, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "reason", Pen: 0x29d0, ID: 0x6, Type: 3, Length: 0x4, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "reasonText", Pen: 0x29d0, ID: 0x7, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "bindIPv4Address", Pen: 0x29d0, ID: 0x8, Type: 18, Length: 0x4})
+	r.Register(InformationElement{Name: "bindTransportPort", Pen: 0x29d0, ID: 0x9, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "connIPv4Address", Pen: 0x29d0, ID: 0xa, Type: 18, Length: 0x4})
+	r.Register(InformationElement{Name: "connTransportPort", Pen: 0x29d0, ID: 0xb, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "auditCounter", Pen: 0x29d0, ID: 0xc, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics})
+}
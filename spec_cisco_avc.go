@@ -0,0 +1,36 @@
+package ipfix
+
+// GENERATED BY generate_spec; DO NOT CHANGE!
+
+import "sync"
+
+var onceLoadCiscoAVCSpec sync.Once
+
+// LoadCiscoAVCSpec loads information elements from the given specification into the DefaultRegistry.
+// It is safe to call LoadCiscoAVCSpec several times and concurrently.
+func LoadCiscoAVCSpec() {
+	onceLoadCiscoAVCSpec.Do(func() {
+		LoadCiscoAVCSpecInto(DefaultRegistry)
+	})
+}
+
+// LoadCiscoAVCSpecInto registers the information elements from the given specification in r
+func LoadCiscoAVCSpecInto(r *Registry) {
+	r.Register(InformationElement{Name: "connectionClientCounterPacketsRetransmitted", Pen: 0x9, ID: 0x2434, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics, Units: "packets"})
+	r.Register(InformationElement{Name: "connectionTransactionCounterComplete", Pen: 0x9, ID: 0x2438, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics})
+	r.Register(InformationElement{Name: "connectionTransactionDurationSum", Pen: 0x9, ID: 0x2439, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "connectionServerCounterResponses", Pen: 0x9, ID: 0x244c, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics})
+	r.Register(InformationElement{Name: "connectionDelayResponseToServerSum", Pen: 0x9, ID: 0x2457, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "connectionDelayApplicationSum", Pen: 0x9, ID: 0x245a, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "connectionDelayApplicationMax", Pen: 0x9, ID: 0x245b, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "connectionDelayResponseClientToServerSum", Pen: 0x9, ID: 0x245d, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "connectionDelayNetworkClientToServerSum", Pen: 0x9, ID: 0x2461, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "connectionDelayNetworkToClientSum", Pen: 0x9, ID: 0x2464, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "connectionDelayNetworkToServerSum", Pen: 0x9, ID: 0x2467, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "applicationHttpUriStatistics", Pen: 0x9, ID: 0x248d, Type: 0, Length: 0xffff})
+	r.Register(InformationElement{Name: "applicationHttpHost", Pen: 0x9, ID: 0x2fcb, Type: 0, Length: 0xffff})
+	r.Register(InformationElement{Name: "clientIPv4Address", Pen: 0x9, ID: 0x2fcc, Type: 18, Length: 0x4})
+	r.Register(InformationElement{Name: "serverIPv4Address", Pen: 0x9, ID: 0x2fcd, Type: 18, Length: 0x4})
+	r.Register(InformationElement{Name: "clientTransportPort", Pen: 0x9, ID: 0x2fd0, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "serverTransportPort", Pen: 0x9, ID: 0x2fd1, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+}
@@ -2,458 +2,469 @@ package ipfix
 
 // GENERATED BY generate_spec; DO NOT CHANGE!
 
-var loadedLoadIANASpec = false
+import "sync"
 
-// LoadIANASpec loads information elements from the given specification
+var onceLoadIANASpec sync.Once
+
+// LoadIANASpec loads information elements from the given specification into the DefaultRegistry.
+// It is safe to call LoadIANASpec several times and concurrently.
 func LoadIANASpec() {
-	if loadedLoadIANASpec {
-		return
-	}
-	loadedLoadIANASpec = true
-	RegisterInformationElement(InformationElement{Name: "octetDeltaCount", Pen: 0x0, ID: 0x1, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "packetDeltaCount", Pen: 0x0, ID: 0x2, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "deltaFlowCount", Pen: 0x0, ID: 0x3, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "protocolIdentifier", Pen: 0x0, ID: 0x4, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ipClassOfService", Pen: 0x0, ID: 0x5, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "tcpControlBits", Pen: 0x0, ID: 0x6, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "sourceTransportPort", Pen: 0x0, ID: 0x7, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "sourceIPv4Address", Pen: 0x0, ID: 0x8, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "sourceIPv4PrefixLength", Pen: 0x0, ID: 0x9, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ingressInterface", Pen: 0x0, ID: 0xa, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "destinationTransportPort", Pen: 0x0, ID: 0xb, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "destinationIPv4Address", Pen: 0x0, ID: 0xc, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "destinationIPv4PrefixLength", Pen: 0x0, ID: 0xd, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "egressInterface", Pen: 0x0, ID: 0xe, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "ipNextHopIPv4Address", Pen: 0x0, ID: 0xf, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "bgpSourceAsNumber", Pen: 0x0, ID: 0x10, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "bgpDestinationAsNumber", Pen: 0x0, ID: 0x11, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "bgpNextHopIPv4Address", Pen: 0x0, ID: 0x12, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "postMCastPacketDeltaCount", Pen: 0x0, ID: 0x13, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postMCastOctetDeltaCount", Pen: 0x0, ID: 0x14, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowEndSysUpTime", Pen: 0x0, ID: 0x15, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "flowStartSysUpTime", Pen: 0x0, ID: 0x16, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "postOctetDeltaCount", Pen: 0x0, ID: 0x17, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postPacketDeltaCount", Pen: 0x0, ID: 0x18, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "minimumIpTotalLength", Pen: 0x0, ID: 0x19, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "maximumIpTotalLength", Pen: 0x0, ID: 0x1a, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "sourceIPv6Address", Pen: 0x0, ID: 0x1b, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "destinationIPv6Address", Pen: 0x0, ID: 0x1c, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "sourceIPv6PrefixLength", Pen: 0x0, ID: 0x1d, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "destinationIPv6PrefixLength", Pen: 0x0, ID: 0x1e, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "flowLabelIPv6", Pen: 0x0, ID: 0x1f, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "icmpTypeCodeIPv4", Pen: 0x0, ID: 0x20, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "igmpType", Pen: 0x0, ID: 0x21, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "samplingInterval", Pen: 0x0, ID: 0x22, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "samplingAlgorithm", Pen: 0x0, ID: 0x23, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "flowActiveTimeout", Pen: 0x0, ID: 0x24, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "flowIdleTimeout", Pen: 0x0, ID: 0x25, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "engineType", Pen: 0x0, ID: 0x26, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "engineId", Pen: 0x0, ID: 0x27, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "exportedOctetTotalCount", Pen: 0x0, ID: 0x28, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "exportedMessageTotalCount", Pen: 0x0, ID: 0x29, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "exportedFlowRecordTotalCount", Pen: 0x0, ID: 0x2a, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "ipv4RouterSc", Pen: 0x0, ID: 0x2b, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "sourceIPv4Prefix", Pen: 0x0, ID: 0x2c, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "destinationIPv4Prefix", Pen: 0x0, ID: 0x2d, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mplsTopLabelType", Pen: 0x0, ID: 0x2e, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "mplsTopLabelIPv4Address", Pen: 0x0, ID: 0x2f, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "samplerId", Pen: 0x0, ID: 0x30, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "samplerMode", Pen: 0x0, ID: 0x31, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "samplerRandomInterval", Pen: 0x0, ID: 0x32, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "classId", Pen: 0x0, ID: 0x33, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "minimumTTL", Pen: 0x0, ID: 0x34, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "maximumTTL", Pen: 0x0, ID: 0x35, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "fragmentIdentification", Pen: 0x0, ID: 0x36, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "postIpClassOfService", Pen: 0x0, ID: 0x37, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "sourceMacAddress", Pen: 0x0, ID: 0x38, Type: 12, Length: 0x6})
-	RegisterInformationElement(InformationElement{Name: "postDestinationMacAddress", Pen: 0x0, ID: 0x39, Type: 12, Length: 0x6})
-	RegisterInformationElement(InformationElement{Name: "vlanId", Pen: 0x0, ID: 0x3a, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "postVlanId", Pen: 0x0, ID: 0x3b, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "ipVersion", Pen: 0x0, ID: 0x3c, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "flowDirection", Pen: 0x0, ID: 0x3d, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ipNextHopIPv6Address", Pen: 0x0, ID: 0x3e, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "bgpNextHopIPv6Address", Pen: 0x0, ID: 0x3f, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "ipv6ExtensionHeaders", Pen: 0x0, ID: 0x40, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mplsTopLabelStackSection", Pen: 0x0, ID: 0x46, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection2", Pen: 0x0, ID: 0x47, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection3", Pen: 0x0, ID: 0x48, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection4", Pen: 0x0, ID: 0x49, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection5", Pen: 0x0, ID: 0x4a, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection6", Pen: 0x0, ID: 0x4b, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection7", Pen: 0x0, ID: 0x4c, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection8", Pen: 0x0, ID: 0x4d, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection9", Pen: 0x0, ID: 0x4e, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection10", Pen: 0x0, ID: 0x4f, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "destinationMacAddress", Pen: 0x0, ID: 0x50, Type: 12, Length: 0x6})
-	RegisterInformationElement(InformationElement{Name: "postSourceMacAddress", Pen: 0x0, ID: 0x51, Type: 12, Length: 0x6})
-	RegisterInformationElement(InformationElement{Name: "interfaceName", Pen: 0x0, ID: 0x52, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "interfaceDescription", Pen: 0x0, ID: 0x53, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "samplerName", Pen: 0x0, ID: 0x54, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "octetTotalCount", Pen: 0x0, ID: 0x55, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "packetTotalCount", Pen: 0x0, ID: 0x56, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flagsAndSamplerId", Pen: 0x0, ID: 0x57, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "fragmentOffset", Pen: 0x0, ID: 0x58, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "forwardingStatus", Pen: 0x0, ID: 0x59, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "mplsVpnRouteDistinguisher", Pen: 0x0, ID: 0x5a, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsTopLabelPrefixLength", Pen: 0x0, ID: 0x5b, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "srcTrafficIndex", Pen: 0x0, ID: 0x5c, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "dstTrafficIndex", Pen: 0x0, ID: 0x5d, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "applicationDescription", Pen: 0x0, ID: 0x5e, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "applicationId", Pen: 0x0, ID: 0x5f, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "applicationName", Pen: 0x0, ID: 0x60, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "postIpDiffServCodePoint", Pen: 0x0, ID: 0x62, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "multicastReplicationFactor", Pen: 0x0, ID: 0x63, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "className", Pen: 0x0, ID: 0x64, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "classificationEngineId", Pen: 0x0, ID: 0x65, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "layer2packetSectionOffset", Pen: 0x0, ID: 0x66, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "layer2packetSectionSize", Pen: 0x0, ID: 0x67, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "layer2packetSectionData", Pen: 0x0, ID: 0x68, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "bgpNextAdjacentAsNumber", Pen: 0x0, ID: 0x80, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "bgpPrevAdjacentAsNumber", Pen: 0x0, ID: 0x81, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "exporterIPv4Address", Pen: 0x0, ID: 0x82, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "exporterIPv6Address", Pen: 0x0, ID: 0x83, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "droppedOctetDeltaCount", Pen: 0x0, ID: 0x84, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "droppedPacketDeltaCount", Pen: 0x0, ID: 0x85, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "droppedOctetTotalCount", Pen: 0x0, ID: 0x86, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "droppedPacketTotalCount", Pen: 0x0, ID: 0x87, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowEndReason", Pen: 0x0, ID: 0x88, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "commonPropertiesId", Pen: 0x0, ID: 0x89, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "observationPointId", Pen: 0x0, ID: 0x8a, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "icmpTypeCodeIPv6", Pen: 0x0, ID: 0x8b, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "mplsTopLabelIPv6Address", Pen: 0x0, ID: 0x8c, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "lineCardId", Pen: 0x0, ID: 0x8d, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "portId", Pen: 0x0, ID: 0x8e, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "meteringProcessId", Pen: 0x0, ID: 0x8f, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "exportingProcessId", Pen: 0x0, ID: 0x90, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "templateId", Pen: 0x0, ID: 0x91, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "wlanChannelId", Pen: 0x0, ID: 0x92, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "wlanSSID", Pen: 0x0, ID: 0x93, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "flowId", Pen: 0x0, ID: 0x94, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "observationDomainId", Pen: 0x0, ID: 0x95, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "flowStartSeconds", Pen: 0x0, ID: 0x96, Type: 14, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "flowEndSeconds", Pen: 0x0, ID: 0x97, Type: 14, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "flowStartMilliseconds", Pen: 0x0, ID: 0x98, Type: 15, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowEndMilliseconds", Pen: 0x0, ID: 0x99, Type: 15, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowStartMicroseconds", Pen: 0x0, ID: 0x9a, Type: 16, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowEndMicroseconds", Pen: 0x0, ID: 0x9b, Type: 16, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowStartNanoseconds", Pen: 0x0, ID: 0x9c, Type: 17, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowEndNanoseconds", Pen: 0x0, ID: 0x9d, Type: 17, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowStartDeltaMicroseconds", Pen: 0x0, ID: 0x9e, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "flowEndDeltaMicroseconds", Pen: 0x0, ID: 0x9f, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "systemInitTimeMilliseconds", Pen: 0x0, ID: 0xa0, Type: 15, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowDurationMilliseconds", Pen: 0x0, ID: 0xa1, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "flowDurationMicroseconds", Pen: 0x0, ID: 0xa2, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "observedFlowTotalCount", Pen: 0x0, ID: 0xa3, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "ignoredPacketTotalCount", Pen: 0x0, ID: 0xa4, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "ignoredOctetTotalCount", Pen: 0x0, ID: 0xa5, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "notSentFlowTotalCount", Pen: 0x0, ID: 0xa6, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "notSentPacketTotalCount", Pen: 0x0, ID: 0xa7, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "notSentOctetTotalCount", Pen: 0x0, ID: 0xa8, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "destinationIPv6Prefix", Pen: 0x0, ID: 0xa9, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "sourceIPv6Prefix", Pen: 0x0, ID: 0xaa, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "postOctetTotalCount", Pen: 0x0, ID: 0xab, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postPacketTotalCount", Pen: 0x0, ID: 0xac, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowKeyIndicator", Pen: 0x0, ID: 0xad, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postMCastPacketTotalCount", Pen: 0x0, ID: 0xae, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postMCastOctetTotalCount", Pen: 0x0, ID: 0xaf, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "icmpTypeIPv4", Pen: 0x0, ID: 0xb0, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "icmpCodeIPv4", Pen: 0x0, ID: 0xb1, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "icmpTypeIPv6", Pen: 0x0, ID: 0xb2, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "icmpCodeIPv6", Pen: 0x0, ID: 0xb3, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "udpSourcePort", Pen: 0x0, ID: 0xb4, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "udpDestinationPort", Pen: 0x0, ID: 0xb5, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "tcpSourcePort", Pen: 0x0, ID: 0xb6, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "tcpDestinationPort", Pen: 0x0, ID: 0xb7, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "tcpSequenceNumber", Pen: 0x0, ID: 0xb8, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "tcpAcknowledgementNumber", Pen: 0x0, ID: 0xb9, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "tcpWindowSize", Pen: 0x0, ID: 0xba, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "tcpUrgentPointer", Pen: 0x0, ID: 0xbb, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "tcpHeaderLength", Pen: 0x0, ID: 0xbc, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ipHeaderLength", Pen: 0x0, ID: 0xbd, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "totalLengthIPv4", Pen: 0x0, ID: 0xbe, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "payloadLengthIPv6", Pen: 0x0, ID: 0xbf, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "ipTTL", Pen: 0x0, ID: 0xc0, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "nextHeaderIPv6", Pen: 0x0, ID: 0xc1, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "mplsPayloadLength", Pen: 0x0, ID: 0xc2, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "ipDiffServCodePoint", Pen: 0x0, ID: 0xc3, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ipPrecedence", Pen: 0x0, ID: 0xc4, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "fragmentFlags", Pen: 0x0, ID: 0xc5, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "octetDeltaSumOfSquares", Pen: 0x0, ID: 0xc6, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "octetTotalSumOfSquares", Pen: 0x0, ID: 0xc7, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "mplsTopLabelTTL", Pen: 0x0, ID: 0xc8, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackLength", Pen: 0x0, ID: 0xc9, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackDepth", Pen: 0x0, ID: 0xca, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mplsTopLabelExp", Pen: 0x0, ID: 0xcb, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ipPayloadLength", Pen: 0x0, ID: 0xcc, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "udpMessageLength", Pen: 0x0, ID: 0xcd, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "isMulticast", Pen: 0x0, ID: 0xce, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ipv4IHL", Pen: 0x0, ID: 0xcf, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ipv4Options", Pen: 0x0, ID: 0xd0, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "tcpOptions", Pen: 0x0, ID: 0xd1, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "paddingOctets", Pen: 0x0, ID: 0xd2, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "collectorIPv4Address", Pen: 0x0, ID: 0xd3, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "collectorIPv6Address", Pen: 0x0, ID: 0xd4, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "exportInterface", Pen: 0x0, ID: 0xd5, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "exportProtocolVersion", Pen: 0x0, ID: 0xd6, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "exportTransportProtocol", Pen: 0x0, ID: 0xd7, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "collectorTransportPort", Pen: 0x0, ID: 0xd8, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "exporterTransportPort", Pen: 0x0, ID: 0xd9, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "tcpSynTotalCount", Pen: 0x0, ID: 0xda, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "tcpFinTotalCount", Pen: 0x0, ID: 0xdb, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "tcpRstTotalCount", Pen: 0x0, ID: 0xdc, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "tcpPshTotalCount", Pen: 0x0, ID: 0xdd, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "tcpAckTotalCount", Pen: 0x0, ID: 0xde, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "tcpUrgTotalCount", Pen: 0x0, ID: 0xdf, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "ipTotalLength", Pen: 0x0, ID: 0xe0, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postNATSourceIPv4Address", Pen: 0x0, ID: 0xe1, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "postNATDestinationIPv4Address", Pen: 0x0, ID: 0xe2, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "postNAPTSourceTransportPort", Pen: 0x0, ID: 0xe3, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "postNAPTDestinationTransportPort", Pen: 0x0, ID: 0xe4, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "natOriginatingAddressRealm", Pen: 0x0, ID: 0xe5, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "natEvent", Pen: 0x0, ID: 0xe6, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "initiatorOctets", Pen: 0x0, ID: 0xe7, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "responderOctets", Pen: 0x0, ID: 0xe8, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "firewallEvent", Pen: 0x0, ID: 0xe9, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ingressVRFID", Pen: 0x0, ID: 0xea, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "egressVRFID", Pen: 0x0, ID: 0xeb, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "VRFname", Pen: 0x0, ID: 0xec, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "postMplsTopLabelExp", Pen: 0x0, ID: 0xed, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "tcpWindowScale", Pen: 0x0, ID: 0xee, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "biflowDirection", Pen: 0x0, ID: 0xef, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ethernetHeaderLength", Pen: 0x0, ID: 0xf0, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "ethernetPayloadLength", Pen: 0x0, ID: 0xf1, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "ethernetTotalLength", Pen: 0x0, ID: 0xf2, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "dot1qVlanId", Pen: 0x0, ID: 0xf3, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "dot1qPriority", Pen: 0x0, ID: 0xf4, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "dot1qCustomerVlanId", Pen: 0x0, ID: 0xf5, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "dot1qCustomerPriority", Pen: 0x0, ID: 0xf6, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "metroEvcId", Pen: 0x0, ID: 0xf7, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "metroEvcType", Pen: 0x0, ID: 0xf8, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "pseudoWireId", Pen: 0x0, ID: 0xf9, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "pseudoWireType", Pen: 0x0, ID: 0xfa, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "pseudoWireControlWord", Pen: 0x0, ID: 0xfb, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "ingressPhysicalInterface", Pen: 0x0, ID: 0xfc, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "egressPhysicalInterface", Pen: 0x0, ID: 0xfd, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "postDot1qVlanId", Pen: 0x0, ID: 0xfe, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "postDot1qCustomerVlanId", Pen: 0x0, ID: 0xff, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "ethernetType", Pen: 0x0, ID: 0x100, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "postIpPrecedence", Pen: 0x0, ID: 0x101, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "collectionTimeMilliseconds", Pen: 0x0, ID: 0x102, Type: 15, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "exportSctpStreamId", Pen: 0x0, ID: 0x103, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "maxExportSeconds", Pen: 0x0, ID: 0x104, Type: 14, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "maxFlowEndSeconds", Pen: 0x0, ID: 0x105, Type: 14, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "messageMD5Checksum", Pen: 0x0, ID: 0x106, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "messageScope", Pen: 0x0, ID: 0x107, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "minExportSeconds", Pen: 0x0, ID: 0x108, Type: 14, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "minFlowStartSeconds", Pen: 0x0, ID: 0x109, Type: 14, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "opaqueOctets", Pen: 0x0, ID: 0x10a, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "sessionScope", Pen: 0x0, ID: 0x10b, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "maxFlowEndMicroseconds", Pen: 0x0, ID: 0x10c, Type: 16, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "maxFlowEndMilliseconds", Pen: 0x0, ID: 0x10d, Type: 15, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "maxFlowEndNanoseconds", Pen: 0x0, ID: 0x10e, Type: 17, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "minFlowStartMicroseconds", Pen: 0x0, ID: 0x10f, Type: 16, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "minFlowStartMilliseconds", Pen: 0x0, ID: 0x110, Type: 15, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "minFlowStartNanoseconds", Pen: 0x0, ID: 0x111, Type: 17, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "collectorCertificate", Pen: 0x0, ID: 0x112, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "exporterCertificate", Pen: 0x0, ID: 0x113, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "dataRecordsReliability", Pen: 0x0, ID: 0x114, Type: 11, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "observationPointType", Pen: 0x0, ID: 0x115, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "newConnectionDeltaCount", Pen: 0x0, ID: 0x116, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "connectionSumDurationSeconds", Pen: 0x0, ID: 0x117, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "connectionTransactionId", Pen: 0x0, ID: 0x118, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postNATSourceIPv6Address", Pen: 0x0, ID: 0x119, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "postNATDestinationIPv6Address", Pen: 0x0, ID: 0x11a, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "natPoolId", Pen: 0x0, ID: 0x11b, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "natPoolName", Pen: 0x0, ID: 0x11c, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "anonymizationFlags", Pen: 0x0, ID: 0x11d, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "anonymizationTechnique", Pen: 0x0, ID: 0x11e, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "informationElementIndex", Pen: 0x0, ID: 0x11f, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "p2pTechnology", Pen: 0x0, ID: 0x120, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "tunnelTechnology", Pen: 0x0, ID: 0x121, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "encryptedTechnology", Pen: 0x0, ID: 0x122, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "bgpValidityState", Pen: 0x0, ID: 0x126, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "IPSecSPI", Pen: 0x0, ID: 0x127, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "greKey", Pen: 0x0, ID: 0x128, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "natType", Pen: 0x0, ID: 0x129, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "initiatorPackets", Pen: 0x0, ID: 0x12a, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "responderPackets", Pen: 0x0, ID: 0x12b, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "observationDomainName", Pen: 0x0, ID: 0x12c, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "selectionSequenceId", Pen: 0x0, ID: 0x12d, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "selectorId", Pen: 0x0, ID: 0x12e, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "informationElementId", Pen: 0x0, ID: 0x12f, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "selectorAlgorithm", Pen: 0x0, ID: 0x130, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "samplingPacketInterval", Pen: 0x0, ID: 0x131, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "samplingPacketSpace", Pen: 0x0, ID: 0x132, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "samplingTimeInterval", Pen: 0x0, ID: 0x133, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "samplingTimeSpace", Pen: 0x0, ID: 0x134, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "samplingSize", Pen: 0x0, ID: 0x135, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "samplingPopulation", Pen: 0x0, ID: 0x136, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "samplingProbability", Pen: 0x0, ID: 0x137, Type: 10, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "dataLinkFrameSize", Pen: 0x0, ID: 0x138, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "ipHeaderPacketSection", Pen: 0x0, ID: 0x139, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "ipPayloadPacketSection", Pen: 0x0, ID: 0x13a, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "dataLinkFrameSection", Pen: 0x0, ID: 0x13b, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsLabelStackSection", Pen: 0x0, ID: 0x13c, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mplsPayloadPacketSection", Pen: 0x0, ID: 0x13d, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "selectorIdTotalPktsObserved", Pen: 0x0, ID: 0x13e, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "selectorIdTotalPktsSelected", Pen: 0x0, ID: 0x13f, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "absoluteError", Pen: 0x0, ID: 0x140, Type: 10, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "relativeError", Pen: 0x0, ID: 0x141, Type: 10, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "observationTimeSeconds", Pen: 0x0, ID: 0x142, Type: 14, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "observationTimeMilliseconds", Pen: 0x0, ID: 0x143, Type: 15, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "observationTimeMicroseconds", Pen: 0x0, ID: 0x144, Type: 16, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "observationTimeNanoseconds", Pen: 0x0, ID: 0x145, Type: 17, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "digestHashValue", Pen: 0x0, ID: 0x146, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "hashIPPayloadOffset", Pen: 0x0, ID: 0x147, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "hashIPPayloadSize", Pen: 0x0, ID: 0x148, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "hashOutputRangeMin", Pen: 0x0, ID: 0x149, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "hashOutputRangeMax", Pen: 0x0, ID: 0x14a, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "hashSelectedRangeMin", Pen: 0x0, ID: 0x14b, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "hashSelectedRangeMax", Pen: 0x0, ID: 0x14c, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "hashDigestOutput", Pen: 0x0, ID: 0x14d, Type: 11, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "hashInitialiserValue", Pen: 0x0, ID: 0x14e, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "selectorName", Pen: 0x0, ID: 0x14f, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "upperCILimit", Pen: 0x0, ID: 0x150, Type: 10, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "lowerCILimit", Pen: 0x0, ID: 0x151, Type: 10, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "confidenceLevel", Pen: 0x0, ID: 0x152, Type: 10, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "informationElementDataType", Pen: 0x0, ID: 0x153, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "informationElementDescription", Pen: 0x0, ID: 0x154, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "informationElementName", Pen: 0x0, ID: 0x155, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "informationElementRangeBegin", Pen: 0x0, ID: 0x156, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "informationElementRangeEnd", Pen: 0x0, ID: 0x157, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "informationElementSemantics", Pen: 0x0, ID: 0x158, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "informationElementUnits", Pen: 0x0, ID: 0x159, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "privateEnterpriseNumber", Pen: 0x0, ID: 0x15a, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "virtualStationInterfaceId", Pen: 0x0, ID: 0x15b, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "virtualStationInterfaceName", Pen: 0x0, ID: 0x15c, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "virtualStationUUID", Pen: 0x0, ID: 0x15d, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "virtualStationName", Pen: 0x0, ID: 0x15e, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "layer2SegmentId", Pen: 0x0, ID: 0x15f, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "layer2OctetDeltaCount", Pen: 0x0, ID: 0x160, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "layer2OctetTotalCount", Pen: 0x0, ID: 0x161, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "ingressUnicastPacketTotalCount", Pen: 0x0, ID: 0x162, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "ingressMulticastPacketTotalCount", Pen: 0x0, ID: 0x163, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "ingressBroadcastPacketTotalCount", Pen: 0x0, ID: 0x164, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "egressUnicastPacketTotalCount", Pen: 0x0, ID: 0x165, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "egressBroadcastPacketTotalCount", Pen: 0x0, ID: 0x166, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "monitoringIntervalStartMilliSeconds", Pen: 0x0, ID: 0x167, Type: 15, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "monitoringIntervalEndMilliSeconds", Pen: 0x0, ID: 0x168, Type: 15, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "portRangeStart", Pen: 0x0, ID: 0x169, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "portRangeEnd", Pen: 0x0, ID: 0x16a, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "portRangeStepSize", Pen: 0x0, ID: 0x16b, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "portRangeNumPorts", Pen: 0x0, ID: 0x16c, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "staMacAddress", Pen: 0x0, ID: 0x16d, Type: 12, Length: 0x6})
-	RegisterInformationElement(InformationElement{Name: "staIPv4Address", Pen: 0x0, ID: 0x16e, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "wtpMacAddress", Pen: 0x0, ID: 0x16f, Type: 12, Length: 0x6})
-	RegisterInformationElement(InformationElement{Name: "ingressInterfaceType", Pen: 0x0, ID: 0x170, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "egressInterfaceType", Pen: 0x0, ID: 0x171, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "rtpSequenceNumber", Pen: 0x0, ID: 0x172, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "userName", Pen: 0x0, ID: 0x173, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "applicationCategoryName", Pen: 0x0, ID: 0x174, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "applicationSubCategoryName", Pen: 0x0, ID: 0x175, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "applicationGroupName", Pen: 0x0, ID: 0x176, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "originalFlowsPresent", Pen: 0x0, ID: 0x177, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "originalFlowsInitiated", Pen: 0x0, ID: 0x178, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "originalFlowsCompleted", Pen: 0x0, ID: 0x179, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "distinctCountOfSourceIPAddress", Pen: 0x0, ID: 0x17a, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "distinctCountOfDestinationIPAddress", Pen: 0x0, ID: 0x17b, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "distinctCountOfSourceIPv4Address", Pen: 0x0, ID: 0x17c, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "distinctCountOfDestinationIPv4Address", Pen: 0x0, ID: 0x17d, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "distinctCountOfSourceIPv6Address", Pen: 0x0, ID: 0x17e, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "distinctCountOfDestinationIPv6Address", Pen: 0x0, ID: 0x17f, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "valueDistributionMethod", Pen: 0x0, ID: 0x180, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "rfc3550JitterMilliseconds", Pen: 0x0, ID: 0x181, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "rfc3550JitterMicroseconds", Pen: 0x0, ID: 0x182, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "rfc3550JitterNanoseconds", Pen: 0x0, ID: 0x183, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "dot1qDEI", Pen: 0x0, ID: 0x184, Type: 11, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "dot1qCustomerDEI", Pen: 0x0, ID: 0x185, Type: 11, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "flowSelectorAlgorithm", Pen: 0x0, ID: 0x186, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "flowSelectedOctetDeltaCount", Pen: 0x0, ID: 0x187, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowSelectedPacketDeltaCount", Pen: 0x0, ID: 0x188, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowSelectedFlowDeltaCount", Pen: 0x0, ID: 0x189, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "selectorIDTotalFlowsObserved", Pen: 0x0, ID: 0x18a, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "selectorIDTotalFlowsSelected", Pen: 0x0, ID: 0x18b, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "samplingFlowInterval", Pen: 0x0, ID: 0x18c, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "samplingFlowSpacing", Pen: 0x0, ID: 0x18d, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowSamplingTimeInterval", Pen: 0x0, ID: 0x18e, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "flowSamplingTimeSpacing", Pen: 0x0, ID: 0x18f, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "hashFlowDomain", Pen: 0x0, ID: 0x190, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "transportOctetDeltaCount", Pen: 0x0, ID: 0x191, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "transportPacketDeltaCount", Pen: 0x0, ID: 0x192, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "originalExporterIPv4Address", Pen: 0x0, ID: 0x193, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "originalExporterIPv6Address", Pen: 0x0, ID: 0x194, Type: 19, Length: 0x10})
-	RegisterInformationElement(InformationElement{Name: "originalObservationDomainId", Pen: 0x0, ID: 0x195, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "intermediateProcessId", Pen: 0x0, ID: 0x196, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "ignoredDataRecordTotalCount", Pen: 0x0, ID: 0x197, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "dataLinkFrameType", Pen: 0x0, ID: 0x198, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "sectionOffset", Pen: 0x0, ID: 0x199, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "sectionExportedOctets", Pen: 0x0, ID: 0x19a, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "dot1qServiceInstanceTag", Pen: 0x0, ID: 0x19b, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "dot1qServiceInstanceId", Pen: 0x0, ID: 0x19c, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "dot1qServiceInstancePriority", Pen: 0x0, ID: 0x19d, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "dot1qCustomerSourceMacAddress", Pen: 0x0, ID: 0x19e, Type: 12, Length: 0x6})
-	RegisterInformationElement(InformationElement{Name: "dot1qCustomerDestinationMacAddress", Pen: 0x0, ID: 0x19f, Type: 12, Length: 0x6})
-	RegisterInformationElement(InformationElement{Name: "postLayer2OctetDeltaCount", Pen: 0x0, ID: 0x1a1, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postMCastLayer2OctetDeltaCount", Pen: 0x0, ID: 0x1a2, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postLayer2OctetTotalCount", Pen: 0x0, ID: 0x1a4, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "postMCastLayer2OctetTotalCount", Pen: 0x0, ID: 0x1a5, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "minimumLayer2TotalLength", Pen: 0x0, ID: 0x1a6, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "maximumLayer2TotalLength", Pen: 0x0, ID: 0x1a7, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "droppedLayer2OctetDeltaCount", Pen: 0x0, ID: 0x1a8, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "droppedLayer2OctetTotalCount", Pen: 0x0, ID: 0x1a9, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "ignoredLayer2OctetTotalCount", Pen: 0x0, ID: 0x1aa, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "notSentLayer2OctetTotalCount", Pen: 0x0, ID: 0x1ab, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "layer2OctetDeltaSumOfSquares", Pen: 0x0, ID: 0x1ac, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "layer2OctetTotalSumOfSquares", Pen: 0x0, ID: 0x1ad, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "layer2FrameDeltaCount", Pen: 0x0, ID: 0x1ae, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "layer2FrameTotalCount", Pen: 0x0, ID: 0x1af, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "pseudoWireDestinationIPv4Address", Pen: 0x0, ID: 0x1b0, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "ignoredLayer2FrameTotalCount", Pen: 0x0, ID: 0x1b1, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "mibObjectValueInteger", Pen: 0x0, ID: 0x1b2, Type: 7, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mibObjectValueOctetString", Pen: 0x0, ID: 0x1b3, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mibObjectValueOID", Pen: 0x0, ID: 0x1b4, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mibObjectValueBits", Pen: 0x0, ID: 0x1b5, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mibObjectValueIPAddress", Pen: 0x0, ID: 0x1b6, Type: 18, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mibObjectValueCounter", Pen: 0x0, ID: 0x1b7, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "mibObjectValueGauge", Pen: 0x0, ID: 0x1b8, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mibObjectValueTimeTicks", Pen: 0x0, ID: 0x1b9, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mibObjectValueUnsigned", Pen: 0x0, ID: 0x1ba, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mibObjectIdentifier", Pen: 0x0, ID: 0x1bd, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mibSubIdentifier", Pen: 0x0, ID: 0x1be, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "mibIndexIndicator", Pen: 0x0, ID: 0x1bf, Type: 4, Length: 0x8})
-	RegisterInformationElement(InformationElement{Name: "mibCaptureTimeSemantics", Pen: 0x0, ID: 0x1c0, Type: 1, Length: 0x1})
-	RegisterInformationElement(InformationElement{Name: "mibContextEngineID", Pen: 0x0, ID: 0x1c1, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mibContextName", Pen: 0x0, ID: 0x1c2, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mibObjectName", Pen: 0x0, ID: 0x1c3, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mibObjectDescription", Pen: 0x0, ID: 0x1c4, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mibObjectSyntax", Pen: 0x0, ID: 0x1c5, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mibModuleName", Pen: 0x0, ID: 0x1c6, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mobileIMSI", Pen: 0x0, ID: 0x1c7, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "mobileMSISDN", Pen: 0x0, ID: 0x1c8, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "httpStatusCode", Pen: 0x0, ID: 0x1c9, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "sourceTransportPortsLimit", Pen: 0x0, ID: 0x1ca, Type: 2, Length: 0x2})
-	RegisterInformationElement(InformationElement{Name: "httpRequestMethod", Pen: 0x0, ID: 0x1cb, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "httpRequestHost", Pen: 0x0, ID: 0x1cc, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "httpRequestTarget", Pen: 0x0, ID: 0x1cd, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "httpMessageVersion", Pen: 0x0, ID: 0x1ce, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "natInstanceID", Pen: 0x0, ID: 0x1cf, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "internalAddressRealm", Pen: 0x0, ID: 0x1d0, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "externalAddressRealm", Pen: 0x0, ID: 0x1d1, Type: 0, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "natQuotaExceededEvent", Pen: 0x0, ID: 0x1d2, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "natThresholdEvent", Pen: 0x0, ID: 0x1d3, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "httpUserAgent", Pen: 0x0, ID: 0x1d4, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "httpContentType", Pen: 0x0, ID: 0x1d5, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "httpReasonPhrase", Pen: 0x0, ID: 0x1d6, Type: 13, Length: 0xffff})
-	RegisterInformationElement(InformationElement{Name: "maxSessionEntries", Pen: 0x0, ID: 0x1d7, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "maxBIBEntries", Pen: 0x0, ID: 0x1d8, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "maxEntriesPerUser", Pen: 0x0, ID: 0x1d9, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "maxSubscribers", Pen: 0x0, ID: 0x1da, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "maxFragmentsPendingReassembly", Pen: 0x0, ID: 0x1db, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "addressPoolHighThreshold", Pen: 0x0, ID: 0x1dc, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "addressPoolLowThreshold", Pen: 0x0, ID: 0x1dd, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "addressPortMappingHighThreshold", Pen: 0x0, ID: 0x1de, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "addressPortMappingLowThreshold", Pen: 0x0, ID: 0x1df, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "addressPortMappingPerUserHighThreshold", Pen: 0x0, ID: 0x1e0, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "globalAddressMappingHighThreshold", Pen: 0x0, ID: 0x1e1, Type: 3, Length: 0x4})
-	RegisterInformationElement(InformationElement{Name: "vpnIdentifier", Pen: 0x0, ID: 0x1e2, Type: 0, Length: 0xffff})
+	onceLoadIANASpec.Do(func() {
+		LoadIANASpecInto(DefaultRegistry)
+	})
+}
+
+// LoadIANASpecInto registers the information elements from the given specification in r
+func LoadIANASpecInto(r *Registry) {
+	r.Register(InformationElement{Name: "octetDeltaCount", Pen: 0x0, ID: 0x1, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The number of octets since the previous report (if any) in incoming packets for this Flow at the Observation Point. The number of octets includes IP header(s) and IP payload."})
+	r.Register(InformationElement{Name: "packetDeltaCount", Pen: 0x0, ID: 0x2, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "packets", Description: "The number of incoming packets since the previous report (if any) for this Flow at the Observation Point."})
+	r.Register(InformationElement{Name: "deltaFlowCount", Pen: 0x0, ID: 0x3, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "flows", Description: "The conservative count of Original Flows contributing to this Aggregated Flow; may be distributed via any of the methods expressed by the valueDistributionMethod Information Element."})
+	r.Register(InformationElement{Name: "protocolIdentifier", Pen: 0x0, ID: 0x4, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The value of the protocol number in the IP packet header. The protocol number identifies the IP packet payload type. Protocol numbers are defined in the IANA Protocol Numbers registry.\n\nIn Internet Protocol version 4 (IPv4), this is carried in the Protocol field. In Internet Protocol version 6 (IPv6), this is carried in the Next Header field in the last extension header of the packet."})
+	r.Register(InformationElement{Name: "ipClassOfService", Pen: 0x0, ID: 0x5, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "For IPv4 packets, this is the value of the TOS field in the IPv4 packet header. For IPv6 packets, this is the value of the Traffic Class field in the IPv6 packet header."})
+	r.Register(InformationElement{Name: "tcpControlBits", Pen: 0x0, ID: 0x6, Type: 2, Length: 0x2, Semantics: FlagsSemantics, Description: "TCP control bits observed for the packets of this Flow. This information is encoded as a bit field; for each TCP control bit, there is a bit in this set. The bit is set to 1 if any observed packet of this Flow has the corresponding TCP control bit set to 1. The bit is cleared to 0 otherwise.\n\nThe values of each bit are shown below, per the definition of the bits in the TCP header [RFC793][RFC3168][RFC3540]:\n\n MSb                                                         LSb\n  0   1   2   3   4   5   6   7   8   9  10  11  12  13  14  15\n+---+---+---+---+---+---+---+---+---+---+---+---+---+---+---+---+\n|               |           | N | C | E | U | A | P | R | S | F |\n|     Zero      |   Future  | S | W | C | R | C | S | S | Y | I |\n| (Data Offset) |    Use    |   | R | E | G | K | H | T | N | N |\n+---+---+---+---+---+---+---+---+---+---+---+---+---+---+---+---+\n\nbit    flag\nvalue  name  description\n------+-----+-------------------------------------\n0x8000       Zero (see tcpHeaderLength)\n0x4000       Zero (see tcpHeaderLength)\n0x2000       Zero (see tcpHeaderLength)\n0x1000       Zero (see tcpHeaderLength)\n0x0800       Future Use\n0x0400       Future Use\n0x0200       Future Use\n0x0100   NS  ECN Nonce Sum\n0x0080  CWR  Congestion Window Reduced\n0x0040  ECE  ECN Echo\n0x0020  URG  Urgent Pointer field significant\n0x0010  ACK  Acknowledgment field significant\n0x0008  PSH  Push Function\n0x0004  RST  Reset the connection\n0x0002  SYN  Synchronize sequence numbers\n0x0001  FIN  No more data from sender\n\nAs the most significant 4 bits of octets 12 and 13 (counting from zero) of the TCP header [RFC793] are used to encode the TCP data offset (header length), the corresponding bits in this Information Element MUST be exported as zero and MUST be ignored by the collector. Use the tcpHeaderLength Information Element to encode this value.\n\nEach of the 3 bits (0x800, 0x400, and 0x200), which are reserved for future use in [RFC793], SHOULD be exported as observed in the TCP headers of the packets of this Flow.\n\nIf exported as a single octet with reduced-size encoding, this Information Element covers the low-order octet of this field (i.e, bits 0x80 to 0x01), omitting the ECN Nonce Sum and the three Future Use bits. A collector receiving this Information Element with reduced-size encoding must not assume anything about the content of these four bits.\n\nExporting Processes exporting this Information Element on behalf of a Metering Process that is not capable of observing any of the ECN Nonce Sum or Future Use bits SHOULD use reduced-size encoding, and only export the least significant 8 bits of this Information Element.\n\nNote that previous revisions of this Information Element's definition specified that the CWR and ECE bits must be exported as zero, even if observed. Collectors should therefore not assume that a value of zero for these bits in this Information Element indicates the bits were never set in the observed traffic, especially if these bits are zero in every Flow Record sent by a given exporter."})
+	r.Register(InformationElement{Name: "sourceTransportPort", Pen: 0x0, ID: 0x7, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The source port identifier in the transport header. For the transport protocols UDP, TCP, and SCTP, this is the source port number given in the respective header. This field MAY also be used for future transport protocols that have 16-bit source port identifiers."})
+	r.Register(InformationElement{Name: "sourceIPv4Address", Pen: 0x0, ID: 0x8, Type: 18, Length: 0x4, Description: "The IPv4 source address in the IP packet header."})
+	r.Register(InformationElement{Name: "sourceIPv4PrefixLength", Pen: 0x0, ID: 0x9, Type: 1, Length: 0x1, Units: "bits", Range: "0-32", Description: "The number of contiguous bits that are relevant in the sourceIPv4Prefix Information Element."})
+	r.Register(InformationElement{Name: "ingressInterface", Pen: 0x0, ID: 0xa, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The index of the IP interface where packets of this Flow are being received. The value matches the value of managed object 'ifIndex' as defined in [RFC2863]. Note that ifIndex values are not assigned statically to an interface and that the interfaces may be renumbered every time the device's management system is re-initialized, as specified in [RFC2863]."})
+	r.Register(InformationElement{Name: "destinationTransportPort", Pen: 0x0, ID: 0xb, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The destination port identifier in the transport header. For the transport protocols UDP, TCP, and SCTP, this is the destination port number given in the respective header. This field MAY also be used for future transport protocols that have 16-bit destination port identifiers."})
+	r.Register(InformationElement{Name: "destinationIPv4Address", Pen: 0x0, ID: 0xc, Type: 18, Length: 0x4, Description: "The IPv4 destination address in the IP packet header."})
+	r.Register(InformationElement{Name: "destinationIPv4PrefixLength", Pen: 0x0, ID: 0xd, Type: 1, Length: 0x1, Units: "bits", Range: "0-32", Description: "The number of contiguous bits that are relevant in the destinationIPv4Prefix Information Element."})
+	r.Register(InformationElement{Name: "egressInterface", Pen: 0x0, ID: 0xe, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The index of the IP interface where packets of this Flow are being sent. The value matches the value of managed object 'ifIndex' as defined in [RFC2863]. Note that ifIndex values are not assigned statically to an interface and that the interfaces may be renumbered every time the device's management system is re-initialized, as specified in [RFC2863]."})
+	r.Register(InformationElement{Name: "ipNextHopIPv4Address", Pen: 0x0, ID: 0xf, Type: 18, Length: 0x4, Description: "The IPv4 address of the next IPv4 hop."})
+	r.Register(InformationElement{Name: "bgpSourceAsNumber", Pen: 0x0, ID: 0x10, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The autonomous system (AS) number of the source IP address. If AS path information for this Flow is only available as an unordered AS set (and not as an ordered AS sequence), then the value of this Information Element is 0."})
+	r.Register(InformationElement{Name: "bgpDestinationAsNumber", Pen: 0x0, ID: 0x11, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The autonomous system (AS) number of the destination IP address. If AS path information for this Flow is only available as an unordered AS set (and not as an ordered AS sequence), then the value of this Information Element is 0."})
+	r.Register(InformationElement{Name: "bgpNextHopIPv4Address", Pen: 0x0, ID: 0x12, Type: 18, Length: 0x4, Description: "The IPv4 address of the next (adjacent) BGP hop."})
+	r.Register(InformationElement{Name: "postMCastPacketDeltaCount", Pen: 0x0, ID: 0x13, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "packets", Description: "The number of outgoing multicast packets since the previous report (if any) sent for packets of this Flow by a multicast daemon within the Observation Domain. This property cannot necessarily be observed at the Observation Point, but may be retrieved by other means."})
+	r.Register(InformationElement{Name: "postMCastOctetDeltaCount", Pen: 0x0, ID: 0x14, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The number of octets since the previous report (if any) in outgoing multicast packets sent for packets of this Flow by a multicast daemon within the Observation Domain. This property cannot necessarily be observed at the Observation Point, but may be retrieved by other means. The number of octets includes IP header(s) and IP payload."})
+	r.Register(InformationElement{Name: "flowEndSysUpTime", Pen: 0x0, ID: 0x15, Type: 3, Length: 0x4, Units: "milliseconds", Description: "The relative timestamp of the last packet of this Flow. It indicates the number of milliseconds since the last (re-)initialization of the IPFIX Device (sysUpTime). sysUpTime can be calculated from systemInitTimeMilliseconds."})
+	r.Register(InformationElement{Name: "flowStartSysUpTime", Pen: 0x0, ID: 0x16, Type: 3, Length: 0x4, Units: "milliseconds", Description: "The relative timestamp of the first packet of this Flow. It indicates the number of milliseconds since the last (re-)initialization of the IPFIX Device (sysUpTime). sysUpTime can be calculated from systemInitTimeMilliseconds."})
+	r.Register(InformationElement{Name: "postOctetDeltaCount", Pen: 0x0, ID: 0x17, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The definition of this Information Element is identical to the definition of Information Element 'octetDeltaCount', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "postPacketDeltaCount", Pen: 0x0, ID: 0x18, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "packets", Description: "The definition of this Information Element is identical to the definition of Information Element 'packetDeltaCount', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "minimumIpTotalLength", Pen: 0x0, ID: 0x19, Type: 4, Length: 0x8, Units: "octets", Description: "Length of the smallest packet observed for this Flow. The packet length includes the IP header(s) length and the IP payload length."})
+	r.Register(InformationElement{Name: "maximumIpTotalLength", Pen: 0x0, ID: 0x1a, Type: 4, Length: 0x8, Units: "octets", Description: "Length of the largest packet observed for this Flow. The packet length includes the IP header(s) length and the IP payload length."})
+	r.Register(InformationElement{Name: "sourceIPv6Address", Pen: 0x0, ID: 0x1b, Type: 19, Length: 0x10, Description: "The IPv6 source address in the IP packet header."})
+	r.Register(InformationElement{Name: "destinationIPv6Address", Pen: 0x0, ID: 0x1c, Type: 19, Length: 0x10, Description: "The IPv6 destination address in the IP packet header."})
+	r.Register(InformationElement{Name: "sourceIPv6PrefixLength", Pen: 0x0, ID: 0x1d, Type: 1, Length: 0x1, Units: "bits", Range: "0-128", Description: "The number of contiguous bits that are relevant in the sourceIPv6Prefix Information Element."})
+	r.Register(InformationElement{Name: "destinationIPv6PrefixLength", Pen: 0x0, ID: 0x1e, Type: 1, Length: 0x1, Units: "bits", Range: "0-128", Description: "The number of contiguous bits that are relevant in the destinationIPv6Prefix Information Element."})
+	r.Register(InformationElement{Name: "flowLabelIPv6", Pen: 0x0, ID: 0x1f, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Range: "0-0xFFFFF", Description: "The value of the IPv6 Flow Label field in the IP packet header."})
+	r.Register(InformationElement{Name: "icmpTypeCodeIPv4", Pen: 0x0, ID: 0x20, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "Type and Code of the IPv4 ICMP message. The combination of both values is reported as (ICMP type * 256) + ICMP code."})
+	r.Register(InformationElement{Name: "igmpType", Pen: 0x0, ID: 0x21, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The type field of the IGMP message."})
+	r.Register(InformationElement{Name: "samplingInterval", Pen: 0x0, ID: 0x22, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "packets", Status: DeprecatedStatus, Description: "Deprecated in favor of 305 samplingPacketInterval. When using sampled NetFlow, the rate at which packets are sampled -- e.g., a value of 100 indicates that one of every 100 packets is sampled."})
+	r.Register(InformationElement{Name: "samplingAlgorithm", Pen: 0x0, ID: 0x23, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Status: DeprecatedStatus, Description: "Deprecated in favor of 304 selectorAlgorithm. The type of algorithm used for sampled NetFlow:\n\n1 - Deterministic Sampling,\n2 - Random Sampling.\n\nThe values are not compatible with the selectorAlgorithm IE, where \"Deterministic\" has been replaced by \"Systematic count-based\" (1) or \"Systematic time-based\" (2), and \"Random\" is (3). Conversion is required; see [psamp-parameters]Packet Sampling (PSAMP) Parameters."})
+	r.Register(InformationElement{Name: "flowActiveTimeout", Pen: 0x0, ID: 0x24, Type: 2, Length: 0x2, Units: "seconds", Description: "The number of seconds after which an active Flow is timed out anyway, even if there is still a continuous flow of packets."})
+	r.Register(InformationElement{Name: "flowIdleTimeout", Pen: 0x0, ID: 0x25, Type: 2, Length: 0x2, Units: "seconds", Description: "A Flow is considered to be timed out if no packets belonging to the Flow have been observed for the number of seconds specified by this field."})
+	r.Register(InformationElement{Name: "engineType", Pen: 0x0, ID: 0x26, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Status: DeprecatedStatus, Description: "Type of flow switching engine in a router/switch:\n\nRP = 0,\nVIP/Line card = 1,\nPFC/DFC = 2.\n\nReserved for internal use on the Collector."})
+	r.Register(InformationElement{Name: "engineId", Pen: 0x0, ID: 0x27, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Status: DeprecatedStatus, Description: "Versatile Interface Processor (VIP) or line card slot number of the flow switching engine in a router/switch. Reserved for internal use on the Collector."})
+	r.Register(InformationElement{Name: "exportedOctetTotalCount", Pen: 0x0, ID: 0x28, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of octets that the Exporting Process has sent since the Exporting Process (re-)initialization to a particular Collecting Process. The value of this Information Element is calculated by summing up the IPFIX Message Header length values of all IPFIX Messages that were successfully sent to the Collecting Process. The reported number excludes octets in the IPFIX Message that carries the counter value. If this Information Element is sent to a particular Collecting Process, then by default it specifies the number of octets sent to this Collecting Process."})
+	r.Register(InformationElement{Name: "exportedMessageTotalCount", Pen: 0x0, ID: 0x29, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "messages", Description: "The total number of IPFIX Messages that the Exporting Process has sent since the Exporting Process (re-)initialization to a particular Collecting Process. The reported number excludes the IPFIX Message that carries the counter value. If this Information Element is sent to a particular Collecting Process, then by default it specifies the number of IPFIX Messages sent to this Collecting Process."})
+	r.Register(InformationElement{Name: "exportedFlowRecordTotalCount", Pen: 0x0, ID: 0x2a, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "flows", Description: "The total number of Flow Records that the Exporting Process has sent as Data Records since the Exporting Process (re-)initialization to a particular Collecting Process. The reported number excludes Flow Records in the IPFIX Message that carries the counter value. If this Information Element is sent to a particular Collecting Process, then by default it specifies the number of Flow Records sent to this process."})
+	r.Register(InformationElement{Name: "ipv4RouterSc", Pen: 0x0, ID: 0x2b, Type: 18, Length: 0x4, Status: DeprecatedStatus, Description: "This is a platform-specific field for the Catalyst 5000/Catalyst 6000 family. It is used to store the address of a router that is being shortcut when performing MultiLayer Switching."})
+	r.Register(InformationElement{Name: "sourceIPv4Prefix", Pen: 0x0, ID: 0x2c, Type: 18, Length: 0x4, Description: "IPv4 source address prefix."})
+	r.Register(InformationElement{Name: "destinationIPv4Prefix", Pen: 0x0, ID: 0x2d, Type: 18, Length: 0x4, Description: "IPv4 destination address prefix."})
+	r.Register(InformationElement{Name: "mplsTopLabelType", Pen: 0x0, ID: 0x2e, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "This field identifies the control protocol that allocated the top-of-stack label. Values for this field are listed in the MPLS label type registry. See [http://www.iana.org/assignments/ipfix/ipfix.xml#ipfix-mpls-label-type]"})
+	r.Register(InformationElement{Name: "mplsTopLabelIPv4Address", Pen: 0x0, ID: 0x2f, Type: 18, Length: 0x4, Description: "The IPv4 address of the system that the MPLS top label will cause this Flow to be forwarded to."})
+	r.Register(InformationElement{Name: "samplerId", Pen: 0x0, ID: 0x30, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Status: DeprecatedStatus, Description: "Deprecated in favor of 302 selectorId. The unique identifier associated with samplerName."})
+	r.Register(InformationElement{Name: "samplerMode", Pen: 0x0, ID: 0x31, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Status: DeprecatedStatus, Description: "Deprecated in favor of 304 selectorAlgorithm. The values are not compatible: selectorAlgorithm=3 is random sampling. The type of algorithm used for sampling data: 1 - Deterministic, 2 - Random Sampling. Use with samplerRandomInterval."})
+	r.Register(InformationElement{Name: "samplerRandomInterval", Pen: 0x0, ID: 0x32, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Status: DeprecatedStatus, Description: "Deprecated in favor of 305 samplingPacketInterval. Packet interval at which to sample -- in case of random sampling. Used in connection with the samplerMode 0x02 (random sampling) value."})
+	r.Register(InformationElement{Name: "classId", Pen: 0x0, ID: 0x33, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Status: DeprecatedStatus, Description: "Deprecated in favor of 302 selectorId. Characterizes the traffic class, i.e., QoS treatment."})
+	r.Register(InformationElement{Name: "minimumTTL", Pen: 0x0, ID: 0x34, Type: 1, Length: 0x1, Units: "hops", Description: "Minimum TTL value observed for any packet in this Flow."})
+	r.Register(InformationElement{Name: "maximumTTL", Pen: 0x0, ID: 0x35, Type: 1, Length: 0x1, Units: "hops", Description: "Maximum TTL value observed for any packet in this Flow."})
+	r.Register(InformationElement{Name: "fragmentIdentification", Pen: 0x0, ID: 0x36, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The value of the Identification field in the IPv4 packet header or in the IPv6 Fragment header, respectively. The value is 0 for IPv6 if there is no fragment header."})
+	r.Register(InformationElement{Name: "postIpClassOfService", Pen: 0x0, ID: 0x37, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The definition of this Information Element is identical to the definition of Information Element 'ipClassOfService', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "sourceMacAddress", Pen: 0x0, ID: 0x38, Type: 12, Length: 0x6, Description: "The IEEE 802 source MAC address field."})
+	r.Register(InformationElement{Name: "postDestinationMacAddress", Pen: 0x0, ID: 0x39, Type: 12, Length: 0x6, Description: "The definition of this Information Element is identical to the definition of Information Element 'destinationMacAddress', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "vlanId", Pen: 0x0, ID: 0x3a, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "Virtual LAN identifier associated with ingress interface. For dot1q vlans, see 243 dot1qVlanId."})
+	r.Register(InformationElement{Name: "postVlanId", Pen: 0x0, ID: 0x3b, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "Virtual LAN identifier associated with egress interface. For postdot1q vlans, see 254, postDot1qVlanId."})
+	r.Register(InformationElement{Name: "ipVersion", Pen: 0x0, ID: 0x3c, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The IP version field in the IP packet header."})
+	r.Register(InformationElement{Name: "flowDirection", Pen: 0x0, ID: 0x3d, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The direction of the Flow observed at the Observation Point. There are only two values defined.\n\n0x00: ingress flow\n0x01: egress flow"})
+	r.Register(InformationElement{Name: "ipNextHopIPv6Address", Pen: 0x0, ID: 0x3e, Type: 19, Length: 0x10, Description: "The IPv6 address of the next IPv6 hop."})
+	r.Register(InformationElement{Name: "bgpNextHopIPv6Address", Pen: 0x0, ID: 0x3f, Type: 19, Length: 0x10, Description: "The IPv6 address of the next (adjacent) BGP hop."})
+	r.Register(InformationElement{Name: "ipv6ExtensionHeaders", Pen: 0x0, ID: 0x40, Type: 3, Length: 0x4, Semantics: FlagsSemantics, Description: "IPv6 extension headers observed in packets of this Flow. The information is encoded in a set of bit fields. For each IPv6 option header, there is a bit in this set. The bit is set to 1 if any observed packet of this Flow contains the corresponding IPv6 extension header. Otherwise, if no observed packet of this Flow contained the respective IPv6 extension header, the value of the corresponding bit is 0.\n\n        0     1     2     3     4     5     6     7\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n    | DST | HOP | Res | UNK |FRA0 | RH  |FRA1 | Res |  ...\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n\n        8     9    10    11    12    13    14    15\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n... |           Reserved    | MOB | ESP | AH  | PAY | ...\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n\n       16    17    18    19    20    21    22    23\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n... |                  Reserved                     | ...\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n\n       24    25    26    27    28    29    30    31\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n... |                  Reserved                     |\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n\nBit    IPv6 Option   Description\n\n0, DST      60       Destination option header\n1, HOP       0       Hop-by-hop option header\n2, Res               Reserved\n3, UNK               Unknown Layer 4 header\n                     (compressed, encrypted, not supported)\n4, FRA0     44       Fragment header - first fragment\n5, RH       43       Routing header\n6, FRA1     44       Fragmentation header - not first fragment\n7, Res               Reserved\n8 to 11              Reserved\n12, MOB     135      IPv6 mobility [RFC3775]\n13, ESP      50      Encrypted security payload\n14, AH       51      Authentication Header\n15, PAY     108      Payload compression header\n16 to 31             Reserved"})
+	r.Register(InformationElement{Name: "mplsTopLabelStackSection", Pen: 0x0, ID: 0x46, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the top MPLS label stack entry, i.e., from the last label that was pushed.\n\nThe size of this Information Element is 3 octets.\n\n 0                   1                   2\n 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3\n+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+\n|                Label                  | Exp |S|\n+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+\n\nLabel:  Label Value, 20 bits\nExp:    Experimental Use, 3 bits\nS:      Bottom of Stack, 1 bit"})
+	r.Register(InformationElement{Name: "mplsLabelStackSection2", Pen: 0x0, ID: 0x47, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the label stack entry that was pushed immediately before the label stack entry that would be reported by mplsTopLabelStackSection. See the definition of mplsTopLabelStackSection for further details.\n\nThe size of this Information Element is 3 octets."})
+	r.Register(InformationElement{Name: "mplsLabelStackSection3", Pen: 0x0, ID: 0x48, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the label stack entry that was pushed immediately before the label stack entry that would be reported by mplsLabelStackSection2. See the definition of mplsTopLabelStackSection for further details.\n\nThe size of this Information Element is 3 octets."})
+	r.Register(InformationElement{Name: "mplsLabelStackSection4", Pen: 0x0, ID: 0x49, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the label stack entry that was pushed immediately before the label stack entry that would be reported by mplsLabelStackSection3. See the definition of mplsTopLabelStackSection for further details.\n\nThe size of this Information Element is 3 octets."})
+	r.Register(InformationElement{Name: "mplsLabelStackSection5", Pen: 0x0, ID: 0x4a, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the label stack entry that was pushed immediately before the label stack entry that would be reported by mplsLabelStackSection4. See the definition of mplsTopLabelStackSection for further details.\n\nThe size of this Information Element is 3 octets."})
+	r.Register(InformationElement{Name: "mplsLabelStackSection6", Pen: 0x0, ID: 0x4b, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the label stack entry that was pushed immediately before the label stack entry that would be reported by mplsLabelStackSection5. See the definition of mplsTopLabelStackSection for further details.\n\nThe size of this Information Element is 3 octets."})
+	r.Register(InformationElement{Name: "mplsLabelStackSection7", Pen: 0x0, ID: 0x4c, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the label stack entry that was pushed immediately before the label stack entry that would be reported by mplsLabelStackSection6. See the definition of mplsTopLabelStackSection for further details.\n\nThe size of this Information Element is 3 octets."})
+	r.Register(InformationElement{Name: "mplsLabelStackSection8", Pen: 0x0, ID: 0x4d, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the label stack entry that was pushed immediately before the label stack entry that would be reported by mplsLabelStackSection7. See the definition of mplsTopLabelStackSection for further details.\n\nThe size of this Information Element is 3 octets."})
+	r.Register(InformationElement{Name: "mplsLabelStackSection9", Pen: 0x0, ID: 0x4e, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the label stack entry that was pushed immediately before the label stack entry that would be reported by mplsLabelStackSection8. See the definition of mplsTopLabelStackSection for further details.\n\nThe size of this Information Element is 3 octets."})
+	r.Register(InformationElement{Name: "mplsLabelStackSection10", Pen: 0x0, ID: 0x4f, Type: 0, Length: 0xffff, Description: "The Label, Exp, and S fields from the label stack entry that was pushed immediately before the label stack entry that would be reported by mplsLabelStackSection9. See the definition of mplsTopLabelStackSection for further details.\n\nThe size of this Information Element is 3 octets."})
+	r.Register(InformationElement{Name: "destinationMacAddress", Pen: 0x0, ID: 0x50, Type: 12, Length: 0x6, Description: "The IEEE 802 destination MAC address field."})
+	r.Register(InformationElement{Name: "postSourceMacAddress", Pen: 0x0, ID: 0x51, Type: 12, Length: 0x6, Description: "The definition of this Information Element is identical to the definition of Information Element 'sourceMacAddress', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "interfaceName", Pen: 0x0, ID: 0x52, Type: 13, Length: 0xffff, Description: "A short name uniquely describing an interface, eg \"Eth1/0\"."})
+	r.Register(InformationElement{Name: "interfaceDescription", Pen: 0x0, ID: 0x53, Type: 13, Length: 0xffff, Description: "The description of an interface, eg \"FastEthernet 1/0\" or \"ISP connection\"."})
+	r.Register(InformationElement{Name: "samplerName", Pen: 0x0, ID: 0x54, Type: 13, Length: 0xffff, Status: DeprecatedStatus, Description: "Deprecated in favor of 335 selectorName. Name of the flow sampler."})
+	r.Register(InformationElement{Name: "octetTotalCount", Pen: 0x0, ID: 0x55, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of octets in incoming packets for this Flow at the Observation Point since the Metering Process (re-)initialization for this Observation Point. The number of octets includes IP header(s) and IP payload."})
+	r.Register(InformationElement{Name: "packetTotalCount", Pen: 0x0, ID: 0x56, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of incoming packets for this Flow at the Observation Point since the Metering Process (re-)initialization for this Observation Point."})
+	r.Register(InformationElement{Name: "flagsAndSamplerId", Pen: 0x0, ID: 0x57, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Status: DeprecatedStatus, Description: "Flow flags and the value of the sampler ID (samplerId) combined in one bitmapped field. Reserved for internal use on the Collector."})
+	r.Register(InformationElement{Name: "fragmentOffset", Pen: 0x0, ID: 0x58, Type: 2, Length: 0x2, Semantics: QuantitySemantics, Range: "0-0x1FFF", Description: "The value of the IP fragment offset field in the IPv4 packet header or the IPv6 Fragment header, respectively. The value is 0 for IPv6 if there is no fragment header."})
+	r.Register(InformationElement{Name: "forwardingStatus", Pen: 0x0, ID: 0x59, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "This Information Element describes the forwarding status of the flow and any attached reasons.\n\nThe layout of the encoding is as follows:\n\nMSB  -  0   1   2   3   4   5   6   7  -  LSB\n      +---+---+---+---+---+---+---+---+\n      | Status|  Reason code or flags |\n      +---+---+---+---+---+---+---+---+\n\nSee the Forwarding Status sub-registries at [http://www.iana.org/assignments/ipfix/ipfix.xhtml#forwarding-status].\n\nExamples:\n\nvalue : 0x40 = 64\nbinary: 01000000\ndecode: 01        -> Forward\n          000000  -> No further information\n\nvalue : 0x89 = 137\nbinary: 10001001\ndecode: 10        -> Drop\n          001001  -> Bad TTL"})
+	r.Register(InformationElement{Name: "mplsVpnRouteDistinguisher", Pen: 0x0, ID: 0x5a, Type: 0, Length: 0xffff, Description: "The value of the VPN route distinguisher of a corresponding entry in a VPN routing and forwarding table. Route distinguisher ensures that the same address can be used in several different MPLS VPNs and that it is possible for BGP to carry several completely different routes to that address, one for each VPN. According to [RFC4364], the size of mplsVpnRouteDistinguisher is 8 octets. However, in [RFC4382] an octet string with flexible length was chosen for representing a VPN route distinguisher by object MplsL3VpnRouteDistinguisher. This choice was made in order to be open to future changes of the size. This idea was adopted when choosing octetArray as abstract data type for this Information Element. The maximum length of this Information Element is 256 octets."})
+	r.Register(InformationElement{Name: "mplsTopLabelPrefixLength", Pen: 0x0, ID: 0x5b, Type: 1, Length: 0x1, Semantics: QuantitySemantics, Units: "bits", Range: "0-32", Description: "The prefix length of the subnet of the mplsTopLabelIPv4Address that the MPLS top label will cause the Flow to be forwarded to."})
+	r.Register(InformationElement{Name: "srcTrafficIndex", Pen: 0x0, ID: 0x5c, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "BGP Policy Accounting Source Traffic Index."})
+	r.Register(InformationElement{Name: "dstTrafficIndex", Pen: 0x0, ID: 0x5d, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "BGP Policy Accounting Destination Traffic Index."})
+	r.Register(InformationElement{Name: "applicationDescription", Pen: 0x0, ID: 0x5e, Type: 13, Length: 0xffff, Description: "Specifies the description of an application."})
+	r.Register(InformationElement{Name: "applicationId", Pen: 0x0, ID: 0x5f, Type: 0, Length: 0xffff, Description: "Specifies an Application ID per [RFC6759]."})
+	r.Register(InformationElement{Name: "applicationName", Pen: 0x0, ID: 0x60, Type: 13, Length: 0xffff, Description: "Specifies the name of an application."})
+	r.Register(InformationElement{Name: "postIpDiffServCodePoint", Pen: 0x0, ID: 0x62, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Range: "0-63", Description: "The definition of this Information Element is identical to the definition of Information Element 'ipDiffServCodePoint', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "multicastReplicationFactor", Pen: 0x0, ID: 0x63, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Description: "The amount of multicast replication that's applied to a traffic stream."})
+	r.Register(InformationElement{Name: "className", Pen: 0x0, ID: 0x64, Type: 13, Length: 0xffff, Status: DeprecatedStatus, Description: "Deprecated in favor of 335 selectorName. Traffic Class Name, associated with the classId Information Element."})
+	r.Register(InformationElement{Name: "classificationEngineId", Pen: 0x0, ID: 0x65, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "A unique identifier for the engine that determined the Selector ID. Thus, the Classification Engine ID defines the context for the Selector ID. The Classification Engine can be considered a specific registry for application assignments.\n\nValues for this field are listed in the Classification Engine IDs registry. See [http://www.iana.org/assignments/ipfix/ipfix.xml#classification-engine-ids]"})
+	r.Register(InformationElement{Name: "layer2packetSectionOffset", Pen: 0x0, ID: 0x66, Type: 2, Length: 0x2, Semantics: QuantitySemantics, Status: DeprecatedStatus, Description: "Deprecated in favor of 409 sectionOffset. Layer 2 packet section offset. Potentially a generic packet section offset."})
+	r.Register(InformationElement{Name: "layer2packetSectionSize", Pen: 0x0, ID: 0x67, Type: 2, Length: 0x2, Semantics: QuantitySemantics, Status: DeprecatedStatus, Description: "Deprecated in favor of 312 dataLinkFrameSize. Layer 2 packet section size. Potentially a generic packet section size."})
+	r.Register(InformationElement{Name: "layer2packetSectionData", Pen: 0x0, ID: 0x68, Type: 0, Length: 0xffff, Status: DeprecatedStatus, Description: "Deprecated in favor of 315 dataLinkFrameSection. Layer 2 packet section data."})
+	r.Register(InformationElement{Name: "bgpNextAdjacentAsNumber", Pen: 0x0, ID: 0x80, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The autonomous system (AS) number of the first AS in the AS path to the destination IP address. The path is deduced by looking up the destination IP address of the Flow in the BGP routing information base. If AS path information for this Flow is only available as an unordered AS set (and not as an ordered AS sequence), then the value of this Information Element is 0."})
+	r.Register(InformationElement{Name: "bgpPrevAdjacentAsNumber", Pen: 0x0, ID: 0x81, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The autonomous system (AS) number of the last AS in the AS path from the source IP address. The path is deduced by looking up the source IP address of the Flow in the BGP routing information base. If AS path information for this Flow is only available as an unordered AS set (and not as an ordered AS sequence), then the value of this Information Element is 0. In case of BGP asymmetry, the bgpPrevAdjacentAsNumber might not be able to report the correct value."})
+	r.Register(InformationElement{Name: "exporterIPv4Address", Pen: 0x0, ID: 0x82, Type: 18, Length: 0x4, Description: "The IPv4 address used by the Exporting Process. This is used by the Collector to identify the Exporter in cases where the identity of the Exporter may have been obscured by the use of a proxy."})
+	r.Register(InformationElement{Name: "exporterIPv6Address", Pen: 0x0, ID: 0x83, Type: 19, Length: 0x10, Description: "The IPv6 address used by the Exporting Process. This is used by the Collector to identify the Exporter in cases where the identity of the Exporter may have been obscured by the use of a proxy."})
+	r.Register(InformationElement{Name: "droppedOctetDeltaCount", Pen: 0x0, ID: 0x84, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The number of octets since the previous report (if any) in packets of this Flow dropped by packet treatment. The number of octets includes IP header(s) and IP payload."})
+	r.Register(InformationElement{Name: "droppedPacketDeltaCount", Pen: 0x0, ID: 0x85, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "packets", Description: "The number of packets since the previous report (if any) of this Flow dropped by packet treatment."})
+	r.Register(InformationElement{Name: "droppedOctetTotalCount", Pen: 0x0, ID: 0x86, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of octets in packets of this Flow dropped by packet treatment since the Metering Process (re-)initialization for this Observation Point. The number of octets includes IP header(s) and IP payload."})
+	r.Register(InformationElement{Name: "droppedPacketTotalCount", Pen: 0x0, ID: 0x87, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The number of packets of this Flow dropped by packet treatment since the Metering Process (re-)initialization for this Observation Point."})
+	r.Register(InformationElement{Name: "flowEndReason", Pen: 0x0, ID: 0x88, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The reason for Flow termination. The range of values includes the following:\n\n0x01: idle timeout\n      The Flow was terminated because it was considered to be\n      idle.\n\n0x02: active timeout\n      The Flow was terminated for reporting purposes while it was\n      still active, for example, after the maximum lifetime of\n      unreported Flows was reached.\n\n0x03: end of Flow detected\n      The Flow was terminated because the Metering Process\n      detected signals indicating the end of the Flow,\n      for example, the TCP FIN flag.\n\n0x04: forced end\n      The Flow was terminated because of some external event,\n      for example, a shutdown of the Metering Process initiated\n      by a network management application.\n\n0x05: lack of resources\n      The Flow was terminated because of lack of resources\n      available to the Metering Process and/or the Exporting\n      Process."})
+	r.Register(InformationElement{Name: "commonPropertiesId", Pen: 0x0, ID: 0x89, Type: 4, Length: 0x8, Semantics: IdentifierSemantics, Description: "An identifier of a set of common properties that is unique per Observation Domain and Transport Session. Typically, this Information Element is used to link to information reported in separate Data Records."})
+	r.Register(InformationElement{Name: "observationPointId", Pen: 0x0, ID: 0x8a, Type: 4, Length: 0x8, Semantics: IdentifierSemantics, Description: "An identifier of an Observation Point that is unique per Observation Domain. It is RECOMMENDED that this identifier is also unique per IPFIX Device. Typically, this Information Element is used for limiting the scope of other Information Elements."})
+	r.Register(InformationElement{Name: "icmpTypeCodeIPv6", Pen: 0x0, ID: 0x8b, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "Type and Code of the IPv6 ICMP message. The combination of both values is reported as (ICMP type * 256) + ICMP code."})
+	r.Register(InformationElement{Name: "mplsTopLabelIPv6Address", Pen: 0x0, ID: 0x8c, Type: 19, Length: 0x10, Description: "The IPv6 address of the system that the MPLS top label will cause this Flow to be forwarded to."})
+	r.Register(InformationElement{Name: "lineCardId", Pen: 0x0, ID: 0x8d, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "An identifier of a line card that is unique per IPFIX Device hosting an Observation Point. Typically, this Information Element is used for limiting the scope of other Information Elements."})
+	r.Register(InformationElement{Name: "portId", Pen: 0x0, ID: 0x8e, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "An identifier of a line port that is unique per IPFIX Device hosting an Observation Point. Typically, this Information Element is used for limiting the scope of other Information Elements."})
+	r.Register(InformationElement{Name: "meteringProcessId", Pen: 0x0, ID: 0x8f, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "An identifier of a Metering Process that is unique per IPFIX Device. Typically, this Information Element is used for limiting the scope of other Information Elements. Note that process identifiers are typically assigned dynamically. The Metering Process may be re-started with a different ID."})
+	r.Register(InformationElement{Name: "exportingProcessId", Pen: 0x0, ID: 0x90, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "An identifier of an Exporting Process that is unique per IPFIX Device. Typically, this Information Element is used for limiting the scope of other Information Elements. Note that process identifiers are typically assigned dynamically. The Exporting Process may be re-started with a different ID."})
+	r.Register(InformationElement{Name: "templateId", Pen: 0x0, ID: 0x91, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "An identifier of a Template that is locally unique within a combination of a Transport session and an Observation Domain.\n\nTemplate IDs 0-255 are reserved for Template Sets, Options Template Sets, and other reserved Sets yet to be created. Template IDs of Data Sets are numbered from 256 to 65535.\n\nTypically, this Information Element is used for limiting the scope of other Information Elements. Note that after a re-start of the Exporting Process Template identifiers may be re-assigned."})
+	r.Register(InformationElement{Name: "wlanChannelId", Pen: 0x0, ID: 0x92, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The identifier of the 802.11 (Wi-Fi) channel used."})
+	r.Register(InformationElement{Name: "wlanSSID", Pen: 0x0, ID: 0x93, Type: 13, Length: 0xffff, Description: "The Service Set IDentifier (SSID) identifying an 802.11 (Wi-Fi) network used. According to IEEE.802-11.1999, the SSID is encoded into a string of up to 32 characters."})
+	r.Register(InformationElement{Name: "flowId", Pen: 0x0, ID: 0x94, Type: 4, Length: 0x8, Semantics: IdentifierSemantics, Description: "An identifier of a Flow that is unique within an Observation Domain. This Information Element can be used to distinguish between different Flows if Flow Keys such as IP addresses and port numbers are not reported or are reported in separate records."})
+	r.Register(InformationElement{Name: "observationDomainId", Pen: 0x0, ID: 0x95, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "An identifier of an Observation Domain that is locally unique to an Exporting Process. The Exporting Process uses the Observation Domain ID to uniquely identify to the Collecting Process the Observation Domain where Flows were metered. It is RECOMMENDED that this identifier is also unique per IPFIX Device.\n\nA value of 0 indicates that no specific Observation Domain is identified by this Information Element.\n\nTypically, this Information Element is used for limiting the scope of other Information Elements."})
+	r.Register(InformationElement{Name: "flowStartSeconds", Pen: 0x0, ID: 0x96, Type: 14, Length: 0x4, Units: "seconds", Description: "The absolute timestamp of the first packet of this Flow."})
+	r.Register(InformationElement{Name: "flowEndSeconds", Pen: 0x0, ID: 0x97, Type: 14, Length: 0x4, Units: "seconds", Description: "The absolute timestamp of the last packet of this Flow."})
+	r.Register(InformationElement{Name: "flowStartMilliseconds", Pen: 0x0, ID: 0x98, Type: 15, Length: 0x8, Units: "milliseconds", Description: "The absolute timestamp of the first packet of this Flow."})
+	r.Register(InformationElement{Name: "flowEndMilliseconds", Pen: 0x0, ID: 0x99, Type: 15, Length: 0x8, Units: "milliseconds", Description: "The absolute timestamp of the last packet of this Flow."})
+	r.Register(InformationElement{Name: "flowStartMicroseconds", Pen: 0x0, ID: 0x9a, Type: 16, Length: 0x8, Units: "microseconds", Description: "The absolute timestamp of the first packet of this Flow."})
+	r.Register(InformationElement{Name: "flowEndMicroseconds", Pen: 0x0, ID: 0x9b, Type: 16, Length: 0x8, Units: "microseconds", Description: "The absolute timestamp of the last packet of this Flow."})
+	r.Register(InformationElement{Name: "flowStartNanoseconds", Pen: 0x0, ID: 0x9c, Type: 17, Length: 0x8, Units: "nanoseconds", Description: "The absolute timestamp of the first packet of this Flow."})
+	r.Register(InformationElement{Name: "flowEndNanoseconds", Pen: 0x0, ID: 0x9d, Type: 17, Length: 0x8, Units: "nanoseconds", Description: "The absolute timestamp of the last packet of this Flow."})
+	r.Register(InformationElement{Name: "flowStartDeltaMicroseconds", Pen: 0x0, ID: 0x9e, Type: 3, Length: 0x4, Units: "microseconds", Description: "This is a relative timestamp only valid within the scope of a single IPFIX Message. It contains the negative time offset of the first observed packet of this Flow relative to the export time specified in the IPFIX Message Header."})
+	r.Register(InformationElement{Name: "flowEndDeltaMicroseconds", Pen: 0x0, ID: 0x9f, Type: 3, Length: 0x4, Units: "microseconds", Description: "This is a relative timestamp only valid within the scope of a single IPFIX Message. It contains the negative time offset of the last observed packet of this Flow relative to the export time specified in the IPFIX Message Header."})
+	r.Register(InformationElement{Name: "systemInitTimeMilliseconds", Pen: 0x0, ID: 0xa0, Type: 15, Length: 0x8, Units: "milliseconds", Description: "The absolute timestamp of the last (re-)initialization of the IPFIX Device."})
+	r.Register(InformationElement{Name: "flowDurationMilliseconds", Pen: 0x0, ID: 0xa1, Type: 3, Length: 0x4, Units: "milliseconds", Description: "The difference in time between the first observed packet of this Flow and the last observed packet of this Flow."})
+	r.Register(InformationElement{Name: "flowDurationMicroseconds", Pen: 0x0, ID: 0xa2, Type: 3, Length: 0x4, Units: "microseconds", Description: "The difference in time between the first observed packet of this Flow and the last observed packet of this Flow."})
+	r.Register(InformationElement{Name: "observedFlowTotalCount", Pen: 0x0, ID: 0xa3, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "flows", Description: "The total number of Flows observed in the Observation Domain since the Metering Process (re-)initialization for this Observation Point."})
+	r.Register(InformationElement{Name: "ignoredPacketTotalCount", Pen: 0x0, ID: 0xa4, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of observed IP packets that the Metering Process did not process since the (re-)initialization of the Metering Process."})
+	r.Register(InformationElement{Name: "ignoredOctetTotalCount", Pen: 0x0, ID: 0xa5, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of octets in observed IP packets (including the IP header) that the Metering Process did not process since the (re-)initialization of the Metering Process."})
+	r.Register(InformationElement{Name: "notSentFlowTotalCount", Pen: 0x0, ID: 0xa6, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "flows", Description: "The total number of Flow Records that were generated by the Metering Process and dropped by the Metering Process or by the Exporting Process instead of being sent to the Collecting Process. There are several potential reasons for this including resource shortage and special Flow export policies."})
+	r.Register(InformationElement{Name: "notSentPacketTotalCount", Pen: 0x0, ID: 0xa7, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of packets in Flow Records that were generated by the Metering Process and dropped by the Metering Process or by the Exporting Process instead of being sent to the Collecting Process. There are several potential reasons for this including resource shortage and special Flow export policies."})
+	r.Register(InformationElement{Name: "notSentOctetTotalCount", Pen: 0x0, ID: 0xa8, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of octets in packets in Flow Records that were generated by the Metering Process and dropped by the Metering Process or by the Exporting Process instead of being sent to the Collecting Process. There are several potential reasons for this including resource shortage and special Flow export policies."})
+	r.Register(InformationElement{Name: "destinationIPv6Prefix", Pen: 0x0, ID: 0xa9, Type: 19, Length: 0x10, Description: "IPv6 destination address prefix."})
+	r.Register(InformationElement{Name: "sourceIPv6Prefix", Pen: 0x0, ID: 0xaa, Type: 19, Length: 0x10, Description: "IPv6 source address prefix."})
+	r.Register(InformationElement{Name: "postOctetTotalCount", Pen: 0x0, ID: 0xab, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The definition of this Information Element is identical to the definition of Information Element 'octetTotalCount', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "postPacketTotalCount", Pen: 0x0, ID: 0xac, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The definition of this Information Element is identical to the definition of Information Element 'packetTotalCount', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "flowKeyIndicator", Pen: 0x0, ID: 0xad, Type: 4, Length: 0x8, Semantics: FlagsSemantics, Description: "This set of bit fields is used for marking the Information Elements of a Data Record that serve as Flow Key. Each bit represents an Information Element in the Data Record, with the n-th least significant bit representing the n-th Information Element. A bit set to value 1 indicates that the corresponding Information Element is a Flow Key of the reported Flow. A bit set to value 0 indicates that this is not the case.\n\nIf the Data Record contains more than 64 Information Elements, the corresponding Template SHOULD be designed such that all Flow Keys are among the first 64 Information Elements, because the flowKeyIndicator only contains 64 bits. If the Data Record contains less than 64 Information Elements, then the bits in the flowKeyIndicator for which no corresponding Information Element exists MUST have the value 0."})
+	r.Register(InformationElement{Name: "postMCastPacketTotalCount", Pen: 0x0, ID: 0xae, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of outgoing multicast packets sent for packets of this Flow by a multicast daemon within the Observation Domain since the Metering Process (re-)initialization. This property cannot necessarily be observed at the Observation Point, but may be retrieved by other means."})
+	r.Register(InformationElement{Name: "postMCastOctetTotalCount", Pen: 0x0, ID: 0xaf, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of octets in outgoing multicast packets sent for packets of this Flow by a multicast daemon in the Observation Domain since the Metering Process (re-)initialization. This property cannot necessarily be observed at the Observation Point, but may be retrieved by other means. The number of octets includes IP header(s) and IP payload."})
+	r.Register(InformationElement{Name: "icmpTypeIPv4", Pen: 0x0, ID: 0xb0, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "Type of the IPv4 ICMP message."})
+	r.Register(InformationElement{Name: "icmpCodeIPv4", Pen: 0x0, ID: 0xb1, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "Code of the IPv4 ICMP message."})
+	r.Register(InformationElement{Name: "icmpTypeIPv6", Pen: 0x0, ID: 0xb2, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "Type of the IPv6 ICMP message."})
+	r.Register(InformationElement{Name: "icmpCodeIPv6", Pen: 0x0, ID: 0xb3, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "Code of the IPv6 ICMP message."})
+	r.Register(InformationElement{Name: "udpSourcePort", Pen: 0x0, ID: 0xb4, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The source port identifier in the UDP header."})
+	r.Register(InformationElement{Name: "udpDestinationPort", Pen: 0x0, ID: 0xb5, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The destination port identifier in the UDP header."})
+	r.Register(InformationElement{Name: "tcpSourcePort", Pen: 0x0, ID: 0xb6, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The source port identifier in the TCP header."})
+	r.Register(InformationElement{Name: "tcpDestinationPort", Pen: 0x0, ID: 0xb7, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The destination port identifier in the TCP header."})
+	r.Register(InformationElement{Name: "tcpSequenceNumber", Pen: 0x0, ID: 0xb8, Type: 3, Length: 0x4, Description: "The sequence number in the TCP header."})
+	r.Register(InformationElement{Name: "tcpAcknowledgementNumber", Pen: 0x0, ID: 0xb9, Type: 3, Length: 0x4, Description: "The acknowledgement number in the TCP header."})
+	r.Register(InformationElement{Name: "tcpWindowSize", Pen: 0x0, ID: 0xba, Type: 2, Length: 0x2, Description: "The window field in the TCP header. If the TCP window scale is supported, then TCP window scale must be known to fully interpret the value of this information."})
+	r.Register(InformationElement{Name: "tcpUrgentPointer", Pen: 0x0, ID: 0xbb, Type: 2, Length: 0x2, Description: "The urgent pointer in the TCP header."})
+	r.Register(InformationElement{Name: "tcpHeaderLength", Pen: 0x0, ID: 0xbc, Type: 1, Length: 0x1, Units: "octets", Description: "The length of the TCP header. Note that the value of this Information Element is different from the value of the Data Offset field in the TCP header. The Data Offset field indicates the length of the TCP header in units of 4 octets. This Information Elements specifies the length of the TCP header in units of octets."})
+	r.Register(InformationElement{Name: "ipHeaderLength", Pen: 0x0, ID: 0xbd, Type: 1, Length: 0x1, Units: "octets", Description: "The length of the IP header. For IPv6, the value of this Information Element is 40."})
+	r.Register(InformationElement{Name: "totalLengthIPv4", Pen: 0x0, ID: 0xbe, Type: 2, Length: 0x2, Units: "octets", Description: "The total length of the IPv4 packet."})
+	r.Register(InformationElement{Name: "payloadLengthIPv6", Pen: 0x0, ID: 0xbf, Type: 2, Length: 0x2, Units: "octets", Description: "This Information Element reports the value of the Payload Length field in the IPv6 header. Note that IPv6 extension headers belong to the payload. Also note that in case of a jumbo payload option the value of the Payload Length field in the IPv6 header is zero and so will be the value reported by this Information Element."})
+	r.Register(InformationElement{Name: "ipTTL", Pen: 0x0, ID: 0xc0, Type: 1, Length: 0x1, Units: "hops", Description: "For IPv4, the value of the Information Element matches the value of the Time to Live (TTL) field in the IPv4 packet header. For IPv6, the value of the Information Element matches the value of the Hop Limit field in the IPv6 packet header."})
+	r.Register(InformationElement{Name: "nextHeaderIPv6", Pen: 0x0, ID: 0xc1, Type: 1, Length: 0x1, Description: "The value of the Next Header field of the IPv6 header. The value identifies the type of the following IPv6 extension header or of the following IP payload. Valid values are defined in the IANA Protocol Numbers registry."})
+	r.Register(InformationElement{Name: "mplsPayloadLength", Pen: 0x0, ID: 0xc2, Type: 3, Length: 0x4, Units: "octets", Description: "The size of the MPLS packet without the label stack."})
+	r.Register(InformationElement{Name: "ipDiffServCodePoint", Pen: 0x0, ID: 0xc3, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Range: "0-63", Description: "The value of a Differentiated Services Code Point (DSCP) encoded in the Differentiated Services field. The Differentiated Services field spans the most significant 6 bits of the IPv4 TOS field or the IPv6 Traffic Class field, respectively.\n\nThis Information Element encodes only the 6 bits of the Differentiated Services field. Therefore, its value may range from 0 to 63."})
+	r.Register(InformationElement{Name: "ipPrecedence", Pen: 0x0, ID: 0xc4, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Range: "0-7", Description: "The value of the IP Precedence. The IP Precedence value is encoded in the first 3 bits of the IPv4 TOS field or the IPv6 Traffic Class field, respectively.\n\nThis Information Element encodes only these 3 bits. Therefore, its value may range from 0 to 7."})
+	r.Register(InformationElement{Name: "fragmentFlags", Pen: 0x0, ID: 0xc5, Type: 1, Length: 0x1, Semantics: FlagsSemantics, Description: "Fragmentation properties indicated by flags in the IPv4 packet header or the IPv6 Fragment header, respectively.\n\nBit 0:    (RS) Reserved.\n          The value of this bit MUST be 0 until specified\n          otherwise.\n\nBit 1:    (DF) 0 = May Fragment,  1 = Don't Fragment.\n          Corresponds to the value of the DF flag in the\n          IPv4 header.  Will always be 0 for IPv6 unless\n          a \"don't fragment\" feature is introduced to IPv6.\n\nBit 2:    (MF) 0 = Last Fragment, 1 = More Fragments.\n          Corresponds to the MF flag in the IPv4 header\n          or to the M flag in the IPv6 Fragment header,\n          respectively.  The value is 0 for IPv6 if there\n          is no fragment header.\n\nBits 3-7: (DC) Don't Care.\n          The values of these bits are irrelevant.\n\n     0   1   2   3   4   5   6   7\n   +---+---+---+---+---+---+---+---+\n   | R | D | M | D | D | D | D | D |\n   | S | F | F | C | C | C | C | C |\n   +---+---+---+---+---+---+---+---+"})
+	r.Register(InformationElement{Name: "octetDeltaSumOfSquares", Pen: 0x0, ID: 0xc6, Type: 4, Length: 0x8, Description: "The sum of the squared numbers of octets per incoming packet since the previous report (if any) for this Flow at the Observation Point. The number of octets includes IP header(s) and IP payload."})
+	r.Register(InformationElement{Name: "octetTotalSumOfSquares", Pen: 0x0, ID: 0xc7, Type: 4, Length: 0x8, Units: "octets", Description: "The total sum of the squared numbers of octets in incoming packets for this Flow at the Observation Point since the Metering Process (re-)initialization for this Observation Point. The number of octets includes IP header(s) and IP payload."})
+	r.Register(InformationElement{Name: "mplsTopLabelTTL", Pen: 0x0, ID: 0xc8, Type: 1, Length: 0x1, Units: "hops", Description: "The TTL field from the top MPLS label stack entry, i.e., the last label that was pushed."})
+	r.Register(InformationElement{Name: "mplsLabelStackLength", Pen: 0x0, ID: 0xc9, Type: 3, Length: 0x4, Units: "octets", Description: "The length of the MPLS label stack in units of octets."})
+	r.Register(InformationElement{Name: "mplsLabelStackDepth", Pen: 0x0, ID: 0xca, Type: 3, Length: 0x4, Units: "entries", Description: "The number of labels in the MPLS label stack."})
+	r.Register(InformationElement{Name: "mplsTopLabelExp", Pen: 0x0, ID: 0xcb, Type: 1, Length: 0x1, Semantics: FlagsSemantics, Description: "The Exp field from the top MPLS label stack entry, i.e., the last label that was pushed.\n\nBits 0-4:  Don't Care, value is irrelevant.\nBits 5-7:  MPLS Exp field.\n\n     0   1   2   3   4   5   6   7\n   +---+---+---+---+---+---+---+---+\n   |     don't care    |    Exp    |\n   +---+---+---+---+---+---+---+---+"})
+	r.Register(InformationElement{Name: "ipPayloadLength", Pen: 0x0, ID: 0xcc, Type: 3, Length: 0x4, Units: "octets", Description: "The effective length of the IP payload.\n\nFor IPv4 packets, the value of this Information Element is the difference between the total length of the IPv4 packet (as reported by Information Element totalLengthIPv4) and the length of the IPv4 header (as reported by Information Element headerLengthIPv4).\n\nFor IPv6, the value of the Payload Length field in the IPv6 header is reported except in the case that the value of this field is zero and that there is a valid jumbo payload option. In this case, the value of the Jumbo Payload Length field in the jumbo payload option is reported."})
+	r.Register(InformationElement{Name: "udpMessageLength", Pen: 0x0, ID: 0xcd, Type: 2, Length: 0x2, Units: "octets", Description: "The value of the Length field in the UDP header."})
+	r.Register(InformationElement{Name: "isMulticast", Pen: 0x0, ID: 0xce, Type: 1, Length: 0x1, Semantics: FlagsSemantics, Description: "If the IP destination address is not a reserved multicast address, then the value of all bits of the octet (including the reserved ones) is zero.\n\nThe first bit of this octet is set to 1 if the Version field of the IP header has the value 4 and if the Destination Address field contains a reserved multicast address in the range from 224.0.0.0 to 239.255.255.255. Otherwise, this bit is set to 0.\n\nThe second and third bits of this octet are reserved for future use.\n\nThe remaining bits of the octet are only set to values other than zero if the IP Destination Address is a reserved IPv6 multicast address. Then the fourth bit of the octet is set to the value of the T flag in the IPv6 multicast address and the remaining four bits are set to the value of the scope field in the IPv6 multicast address.\n\n      0      1      2      3      4      5      6      7\n   +------+------+------+------+------+------+------+------+\n   |   IPv6 multicast scope    |  T   | RES. | RES. | MCv4 |\n   +------+------+------+------+------+------+------+------+\n\n   Bits 0-3:  set to value of multicast scope if IPv6 multicast\n   Bit  4:    set to value of T flag, if IPv6 multicast\n   Bits 5-6:  reserved for future use\n   Bit  7:    set to 1 if IPv4 multicast"})
+	r.Register(InformationElement{Name: "ipv4IHL", Pen: 0x0, ID: 0xcf, Type: 1, Length: 0x1, Units: "4-octet words", Description: "The value of the Internet Header Length (IHL) field in the IPv4 header. It specifies the length of the header in units of 4 octets. Please note that its unit is different from most of the other Information Elements reporting length values."})
+	r.Register(InformationElement{Name: "ipv4Options", Pen: 0x0, ID: 0xd0, Type: 3, Length: 0x4, Semantics: FlagsSemantics, Description: "IPv4 options in packets of this Flow. The information is encoded in a set of bit fields. For each valid IPv4 option type, there is a bit in this set. The bit is set to 1 if any observed packet of this Flow contains the corresponding IPv4 option type. Otherwise, if no observed packet of this Flow contained the respective IPv4 option type, the value of the corresponding bit is 0.\n\nThe list of valid IPv4 options is maintained by IANA. Note that for identifying an option not just the 5-bit Option Number, but all 8 bits of the Option Type need to match one of the IPv4 options specified at http://www.iana.org/assignments/ip-parameters.\n\nOptions are mapped to bits according to their option numbers. Option number X is mapped to bit X. The mapping is illustrated by the figure below.\n\n        0      1      2      3      4      5      6      7\n    +------+------+------+------+------+------+------+------+\n... |  RR  |CIPSO |E-SEC |  TS  | LSR  |  SEC | NOP  | EOOL |\n    +------+------+------+------+------+------+------+------+\n\n        8      9     10     11     12     13     14     15\n    +------+------+------+------+------+------+------+------+\n... |ENCODE| VISA | FINN | MTUR | MTUP | ZSU  | SSR  | SID  | ...\n    +------+------+------+------+------+------+------+------+\n\n       16     17     18     19     20     21     22     23\n    +------+------+------+------+------+------+------+------+\n... | DPS  |NSAPA | SDB  |RTRALT|ADDEXT|  TR  | EIP  |IMITD | ...\n    +------+------+------+------+------+------+------+------+\n\n       24     25     26     27     28     29     30     31\n    +------+------+------+------+------+------+------+------+\n... |      | EXP  |   to be assigned by IANA  |  QS  | UMP  |\n    +------+------+------+------+------+------+------+------+\n\n       Type   Option\n   Bit Value  Name    Reference\n   ---+-----+-------+------------------------------------\n   0      7   RR      Record Route, RFC 791\n   1    134   CIPSO   Commercial Security\n   2    133   E-SEC   Extended Security, RFC 1108\n   3     68   TS      Time Stamp, RFC 791\n   4    131   LSR     Loose Source Route, RFC791\n   5    130   SEC     Security, RFC 1108\n   6      1   NOP     No Operation, RFC 791\n   7      0   EOOL    End of Options List, RFC 791\n   8     15   ENCODE\n   9    142   VISA    Experimental Access Control\n   10   205   FINN    Experimental Flow Control\n   11    12   MTUR    (obsoleted) MTU Reply, RFC 1191\n   12    11   MTUP    (obsoleted) MTU Probe, RFC 1191\n   13    10   ZSU     Experimental Measurement\n   14   137   SSR     Strict Source Route, RFC 791\n   15   136   SID     Stream ID, RFC 791\n   16   151   DPS     Dynamic Packet State\n   17   150   NSAPA   NSAP Address\n   18   149   SDB     Selective Directed Broadcast\n   19   147   ADDEXT  Address Extension\n   20   148   RTRALT  Router Alert, RFC 2113\n   21    82   TR      Traceroute, RFC 3193\n   22   145   EIP     Extended Internet Protocol, RFC 1385\n   23   144   IMITD   IMI Traffic Descriptor\n   25    30   EXP     RFC3692-style Experiment\n   25    94   EXP     RFC3692-style Experiment\n   25   158   EXP     RFC3692-style Experiment\n   25   222   EXP     RFC3692-style Experiment\n   30    25   QS      Quick-Start\n   31   152   UMP     Upstream Multicast Pkt.\n   ...  ...   ...     Further options numbers\n                      may be assigned by IANA"})
+	r.Register(InformationElement{Name: "tcpOptions", Pen: 0x0, ID: 0xd1, Type: 4, Length: 0x8, Semantics: FlagsSemantics, Description: "TCP options in packets of this Flow. The information is encoded in a set of bit fields. For each TCP option, there is a bit in this set. The bit is set to 1 if any observed packet of this Flow contains the corresponding TCP option. Otherwise, if no observed packet of this Flow contained the respective TCP option, the value of the corresponding bit is 0.\n\nOptions are mapped to bits according to their option numbers. Option number X is mapped to bit X. TCP option numbers are maintained by IANA.\n\n        0     1     2     3     4     5     6     7\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n    |   7 |   6 |   5 |   4 |   3 |   2 |   1 |   0 |  ...\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n\n        8     9    10    11    12    13    14    15\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n... |  15 |  14 |  13 |  12 |  11 |  10 |   9 |   8 |...\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n\n       16    17    18    19    20    21    22    23\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n... |  23 |  22 |  21 |  20 |  19 |  18 |  17 |  16 |...\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n\n                          . . .\n\n       56    57    58    59    60    61    62    63\n    +-----+-----+-----+-----+-----+-----+-----+-----+\n... |  63 |  62 |  61 |  60 |  59 |  58 |  57 |  56 |\n    +-----+-----+-----+-----+-----+-----+-----+-----+"})
+	r.Register(InformationElement{Name: "paddingOctets", Pen: 0x0, ID: 0xd2, Type: 0, Length: 0xffff, Description: "The value of this Information Element is always a sequence of 0x00 values."})
+	r.Register(InformationElement{Name: "collectorIPv4Address", Pen: 0x0, ID: 0xd3, Type: 18, Length: 0x4, Description: "An IPv4 address to which the Exporting Process sends Flow information."})
+	r.Register(InformationElement{Name: "collectorIPv6Address", Pen: 0x0, ID: 0xd4, Type: 19, Length: 0x10, Description: "An IPv6 address to which the Exporting Process sends Flow information."})
+	r.Register(InformationElement{Name: "exportInterface", Pen: 0x0, ID: 0xd5, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The index of the interface from which IPFIX Messages sent by the Exporting Process to a Collector leave the IPFIX Device. The value matches the value of managed object 'ifIndex' as defined in [RFC2863]. Note that ifIndex values are not assigned statically to an interface and that the interfaces may be renumbered every time the device's management system is re-initialized, as specified in [RFC2863]."})
+	r.Register(InformationElement{Name: "exportProtocolVersion", Pen: 0x0, ID: 0xd6, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The protocol version used by the Exporting Process for sending Flow information. The protocol version is given by the value of the Version Number field in the Message Header.\n\nThe protocol version is 10 for IPFIX and 9 for NetFlow version 9. A value of 0 indicates that no export protocol is in use."})
+	r.Register(InformationElement{Name: "exportTransportProtocol", Pen: 0x0, ID: 0xd7, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The value of the protocol number used by the Exporting Process for sending Flow information. The protocol number identifies the IP packet payload type. Protocol numbers are defined in the IANA Protocol Numbers registry.\n\nIn Internet Protocol version 4 (IPv4), this is carried in the Protocol field. In Internet Protocol version 6 (IPv6), this is carried in the Next Header field in the last extension header of the packet."})
+	r.Register(InformationElement{Name: "collectorTransportPort", Pen: 0x0, ID: 0xd8, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The destination port identifier to which the Exporting Process sends Flow information. For the transport protocols UDP, TCP, and SCTP, this is the destination port number. This field MAY also be used for future transport protocols that have 16-bit source port identifiers."})
+	r.Register(InformationElement{Name: "exporterTransportPort", Pen: 0x0, ID: 0xd9, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The source port identifier from which the Exporting Process sends Flow information. For the transport protocols UDP, TCP, and SCTP, this is the source port number. This field MAY also be used for future transport protocols that have 16-bit source port identifiers. This field may be useful for distinguishing multiple Exporting Processes that use the same IP address."})
+	r.Register(InformationElement{Name: "tcpSynTotalCount", Pen: 0x0, ID: 0xda, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of packets of this Flow with TCP \"Synchronize sequence numbers\" (SYN) flag set."})
+	r.Register(InformationElement{Name: "tcpFinTotalCount", Pen: 0x0, ID: 0xdb, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of packets of this Flow with TCP \"No more data from sender\" (FIN) flag set."})
+	r.Register(InformationElement{Name: "tcpRstTotalCount", Pen: 0x0, ID: 0xdc, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of packets of this Flow with TCP \"Reset the connection\" (RST) flag set."})
+	r.Register(InformationElement{Name: "tcpPshTotalCount", Pen: 0x0, ID: 0xdd, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of packets of this Flow with TCP \"Push Function\" (PSH) flag set."})
+	r.Register(InformationElement{Name: "tcpAckTotalCount", Pen: 0x0, ID: 0xde, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of packets of this Flow with TCP \"Acknowledgment field significant\" (ACK) flag set."})
+	r.Register(InformationElement{Name: "tcpUrgTotalCount", Pen: 0x0, ID: 0xdf, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of packets of this Flow with TCP \"Urgent Pointer field significant\" (URG) flag set."})
+	r.Register(InformationElement{Name: "ipTotalLength", Pen: 0x0, ID: 0xe0, Type: 4, Length: 0x8, Units: "octets", Description: "The total length of the IP packet."})
+	r.Register(InformationElement{Name: "postNATSourceIPv4Address", Pen: 0x0, ID: 0xe1, Type: 18, Length: 0x4, Description: "The definition of this Information Element is identical to the definition of Information Element 'sourceIPv4Address', except that it reports a modified value caused by a NAT middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "postNATDestinationIPv4Address", Pen: 0x0, ID: 0xe2, Type: 18, Length: 0x4, Description: "The definition of this Information Element is identical to the definition of Information Element 'destinationIPv4Address', except that it reports a modified value caused by a NAT middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "postNAPTSourceTransportPort", Pen: 0x0, ID: 0xe3, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The definition of this Information Element is identical to the definition of Information Element 'sourceTransportPort', except that it reports a modified value caused by a Network Address Port Translation (NAPT) middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "postNAPTDestinationTransportPort", Pen: 0x0, ID: 0xe4, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The definition of this Information Element is identical to the definition of Information Element 'destinationTransportPort', except that it reports a modified value caused by a Network Address Port Translation (NAPT) middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "natOriginatingAddressRealm", Pen: 0x0, ID: 0xe5, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Range: "1-2", Description: "Indicates whether the session was created because traffic originated in the private or public address realm. postNATSourceIPv4Address, postNATDestinationIPv4Address, postNAPTSourceTransportPort, and postNAPTDestinationTransportPort are qualified with the address realm in perspective.\n\nThe allowed values are:\n\nPrivate: 1\n\nPublic: 2"})
+	r.Register(InformationElement{Name: "natEvent", Pen: 0x0, ID: 0xe6, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "This Information Element identifies a NAT event. This IE identifies the type of a NAT event. Examples of NAT events include, but are not limited to, NAT translation create, NAT translation delete, Threshold Reached, or Threshold Exceeded, etc. Values for this Information Element are listed in the \"NAT Event Type\" registry, see [http://www.iana.org/assignments/ipfix/ipfix.xml#ipfix-nat-event-type]. New assignments of values will be administered by IANA and are subject to Expert Review [RFC8126]. Experts need to check definitions of new values for completeness, accuracy, and redundancy."})
+	r.Register(InformationElement{Name: "initiatorOctets", Pen: 0x0, ID: 0xe7, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The total number of layer 4 payload bytes in a flow from the initiator since the previous report. The initiator is the device which triggered the session creation, and remains the same for the life of the session."})
+	r.Register(InformationElement{Name: "responderOctets", Pen: 0x0, ID: 0xe8, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The total number of layer 4 payload bytes in a flow from the responder since the previous report. The responder is the device which replies to the initiator, and remains the same for the life of the session."})
+	r.Register(InformationElement{Name: "firewallEvent", Pen: 0x0, ID: 0xe9, Type: 1, Length: 0x1, Description: "Indicates a firewall event. The allowed values are:\n\n0 - Ignore (invalid)\n\n1 - Flow Created\n\n2 - Flow Deleted\n\n3 - Flow Denied\n\n4 - Flow Alert\n\n5 - Flow Update"})
+	r.Register(InformationElement{Name: "ingressVRFID", Pen: 0x0, ID: 0xea, Type: 3, Length: 0x4, Description: "An unique identifier of the VRFname where the packets of this flow are being received. This identifier is unique per Metering Process"})
+	r.Register(InformationElement{Name: "egressVRFID", Pen: 0x0, ID: 0xeb, Type: 3, Length: 0x4, Description: "An unique identifier of the VRFname where the packets of this flow are being sent. This identifier is unique per Metering Process"})
+	r.Register(InformationElement{Name: "VRFname", Pen: 0x0, ID: 0xec, Type: 13, Length: 0xffff, Description: "The name of a VPN Routing and Forwarding table (VRF)."})
+	r.Register(InformationElement{Name: "postMplsTopLabelExp", Pen: 0x0, ID: 0xed, Type: 1, Length: 0x1, Semantics: FlagsSemantics, Description: "The definition of this Information Element is identical to the definition of Information Element 'mplsTopLabelExp', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "tcpWindowScale", Pen: 0x0, ID: 0xee, Type: 2, Length: 0x2, Description: "The scale of the window field in the TCP header."})
+	r.Register(InformationElement{Name: "biflowDirection", Pen: 0x0, ID: 0xef, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "A description of the direction assignment method used to assign the Biflow Source and Destination. This Information Element MAY be present in a Flow Data Record, or applied to all flows exported from an Exporting Process or Observation Domain using IPFIX Options. If this Information Element is not present in a Flow Record or associated with a Biflow via scope, it is assumed that the configuration of the direction assignment method is done out-of-band. Note that when using IPFIX Options to apply this Information Element to all flows within an Observation Domain or from an Exporting Process, the Option SHOULD be sent reliably. If reliable transport is not available (i.e., when using UDP), this Information Element SHOULD appear in each Flow Record. This field may take the following values:\n\n+-------+------------------+----------------------------------------+\n| Value | Name             | Description                            |\n+-------+------------------+----------------------------------------+\n| 0x00  | arbitrary        | Direction was assigned arbitrarily.    |\n| 0x01  | initiator        | The Biflow Source is the flow          |\n|       |                  | initiator, as determined by the        |\n|       |                  | Metering Process' best effort to       |\n|       |                  | detect the initiator.                  |\n| 0x02  | reverseInitiator | The Biflow Destination is the flow     |\n|       |                  | initiator, as determined by the        |\n|       |                  | Metering Process' best effort to       |\n|       |                  | detect the initiator.  This value is   |\n|       |                  | provided for the convenience of        |\n|       |                  | Exporting Processes to revise an       |\n|       |                  | initiator estimate without re-encoding |\n|       |                  | the Biflow Record.                     |\n| 0x03  | perimeter        | The Biflow Source is the endpoint      |\n|       |                  | outside of a defined perimeter.  The   |\n|       |                  | perimeter's definition is implicit in  |\n|       |                  | the set of Biflow Source and Biflow    |\n|       |                  | Destination addresses exported in the  |\n|       |                  | Biflow Records.                        |\n+-------+------------------+----------------------------------------+"})
+	r.Register(InformationElement{Name: "ethernetHeaderLength", Pen: 0x0, ID: 0xf0, Type: 1, Length: 0x1, Semantics: QuantitySemantics, Units: "octets", Description: "The difference between the length of an Ethernet frame (minus the FCS) and the length of its MAC Client Data section (including any padding) as defined in section 3.1 of [IEEE.802-3.2005]. It does not include the Preamble, SFD and Extension field lengths."})
+	r.Register(InformationElement{Name: "ethernetPayloadLength", Pen: 0x0, ID: 0xf1, Type: 2, Length: 0x2, Semantics: QuantitySemantics, Units: "octets", Description: "The length of the MAC Client Data section (including any padding) of a frame as defined in section 3.1 of [IEEE.802-3.2005]."})
+	r.Register(InformationElement{Name: "ethernetTotalLength", Pen: 0x0, ID: 0xf2, Type: 2, Length: 0x2, Semantics: QuantitySemantics, Units: "octets", Description: "The total length of the Ethernet frame (excluding the Preamble, SFD, Extension and FCS fields) as described in section 3.1 of [IEEE.802-3.2005]."})
+	r.Register(InformationElement{Name: "dot1qVlanId", Pen: 0x0, ID: 0xf3, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The value of the 12-bit VLAN Identifier portion of the Tag Control Information field of an Ethernet frame. The structure and semantics within the Tag Control Information field are defined in [IEEE802.1Q]. In Provider Bridged Networks, it represents the Service VLAN identifier in the Service VLAN Tag (S-TAG) Tag Control Information (TCI) field or the Customer VLAN identifier in the Customer VLAN Tag (C-TAG) Tag Control Information (TCI) field as described in [IEEE802.1Q]. In Provider Backbone Bridged Networks, it represents the Backbone VLAN identifier in the Backbone VLAN Tag (B-TAG) Tag Control Information (TCI) field as described in [IEEE802.1Q]. In a virtual link between a host system and EVB bridge, it represents the Service VLAN identifier indicating S-channel as described in [IEEE802.1Qbg].\n\nIn the case of a multi-tagged frame, it represents the outer tag's VLAN identifier, except for I-TAG."})
+	r.Register(InformationElement{Name: "dot1qPriority", Pen: 0x0, ID: 0xf4, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The value of the 3-bit User Priority portion of the Tag Control Information field of an Ethernet frame. The structure and semantics within the Tag Control Information field are defined in [IEEE802.1Q]. In the case of multi-tagged frame, it represents the 3-bit Priority Code Point (PCP) portion of the outer tag's Tag Control Information (TCI) field as described in [IEEE802.1Q], except for I-TAG."})
+	r.Register(InformationElement{Name: "dot1qCustomerVlanId", Pen: 0x0, ID: 0xf5, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The value represents the Customer VLAN identifier in the Customer VLAN Tag (C-TAG) Tag Control Information (TCI) field as described in [IEEE802.1Q]."})
+	r.Register(InformationElement{Name: "dot1qCustomerPriority", Pen: 0x0, ID: 0xf6, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The value represents the 3-bit Priority Code Point (PCP) portion of the Customer VLAN Tag (C-TAG) Tag Control Information (TCI) field as described in [IEEE802.1Q]."})
+	r.Register(InformationElement{Name: "metroEvcId", Pen: 0x0, ID: 0xf7, Type: 13, Length: 0xffff, Description: "The EVC Service Attribute which uniquely identifies the Ethernet Virtual Connection (EVC) within a Metro Ethernet Network, as defined in section 6.2 of MEF 10.1. The MetroEVCID is encoded in a string of up to 100 characters."})
+	r.Register(InformationElement{Name: "metroEvcType", Pen: 0x0, ID: 0xf8, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The 3-bit EVC Service Attribute which identifies the type of service provided by an EVC."})
+	r.Register(InformationElement{Name: "pseudoWireId", Pen: 0x0, ID: 0xf9, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "A 32-bit non-zero connection identifier, which together with the pseudoWireType, identifies the Pseudo Wire (PW) as defined in [RFC8077]."})
+	r.Register(InformationElement{Name: "pseudoWireType", Pen: 0x0, ID: 0xfa, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The value of this information element identifies the type of MPLS Pseudo Wire (PW) as defined in [RFC4446]."})
+	r.Register(InformationElement{Name: "pseudoWireControlWord", Pen: 0x0, ID: 0xfb, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The 32-bit Preferred Pseudo Wire (PW) MPLS Control Word as defined in Section 3 of [RFC4385]."})
+	r.Register(InformationElement{Name: "ingressPhysicalInterface", Pen: 0x0, ID: 0xfc, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The index of a networking device's physical interface (example, a switch port) where packets of this flow are being received."})
+	r.Register(InformationElement{Name: "egressPhysicalInterface", Pen: 0x0, ID: 0xfd, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The index of a networking device's physical interface (example, a switch port) where packets of this flow are being sent."})
+	r.Register(InformationElement{Name: "postDot1qVlanId", Pen: 0x0, ID: 0xfe, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The definition of this Information Element is identical to the definition of Information Element 'dot1qVlanId', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "postDot1qCustomerVlanId", Pen: 0x0, ID: 0xff, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The definition of this Information Element is identical to the definition of Information Element 'dot1qCustomerVlanId', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "ethernetType", Pen: 0x0, ID: 0x100, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The Ethernet type field of an Ethernet frame that identifies the MAC client protocol carried in the payload as defined in paragraph 1.4.349 of [IEEE.802-3.2005]."})
+	r.Register(InformationElement{Name: "postIpPrecedence", Pen: 0x0, ID: 0x101, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Range: "0-7", Description: "The definition of this Information Element is identical to the definition of Information Element 'ipPrecedence', except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point."})
+	r.Register(InformationElement{Name: "collectionTimeMilliseconds", Pen: 0x0, ID: 0x102, Type: 15, Length: 0x8, Units: "milliseconds", Description: "The absolute timestamp at which the data within the scope containing this Information Element was received by a Collecting Process. This Information Element SHOULD be bound to its containing IPFIX Message via IPFIX Options and the messageScope Information Element, as defined below."})
+	r.Register(InformationElement{Name: "exportSctpStreamId", Pen: 0x0, ID: 0x103, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The value of the SCTP Stream Identifier used by the Exporting Process for exporting IPFIX Message data. This is carried in the Stream Identifier field of the header of the SCTP DATA chunk containing the IPFIX Message(s)."})
+	r.Register(InformationElement{Name: "maxExportSeconds", Pen: 0x0, ID: 0x104, Type: 14, Length: 0x4, Units: "seconds", Description: "The absolute Export Time of the latest IPFIX Message within the scope containing this Information Element. This Information Element SHOULD be bound to its containing IPFIX Transport Session via IPFIX Options and the sessionScope Information Element."})
+	r.Register(InformationElement{Name: "maxFlowEndSeconds", Pen: 0x0, ID: 0x105, Type: 14, Length: 0x4, Units: "seconds", Description: "The latest absolute timestamp of the last packet within any Flow within the scope containing this Information Element, rounded up to the second if necessary. This Information Element SHOULD be bound to its containing IPFIX Transport Session via IPFIX Options and the sessionScope Information Element."})
+	r.Register(InformationElement{Name: "messageMD5Checksum", Pen: 0x0, ID: 0x106, Type: 0, Length: 0xffff, Description: "The MD5 checksum of the IPFIX Message containing this record. This Information Element SHOULD be bound to its containing IPFIX Message via an options record and the messageScope Information Element, as defined below, and SHOULD appear only once in a given IPFIX Message. To calculate the value of this Information Element, first buffer the containing IPFIX Message, setting the value of this Information Element to all zeroes. Then calculate the MD5 checksum of the resulting buffer as defined in [RFC1321], place the resulting value in this Information Element, and export the buffered message. This Information Element is intended as a simple checksum only; therefore collision resistance and algorithm agility are not required, and MD5 is an appropriate message digest. This Information Element has a fixed length of 16 octets."})
+	r.Register(InformationElement{Name: "messageScope", Pen: 0x0, ID: 0x107, Type: 1, Length: 0x1, Range: "0-0", Description: "The presence of this Information Element as scope in an Options Template signifies that the options described by the Template apply to the IPFIX Message that contains them. It is defined for general purpose message scoping of options, and proposed specifically to allow the attachment a checksum to a message via IPFIX Options. The value of this Information Element MUST be written as 0 by the File Writer or Exporting Process. The value of this Information Element MUST be ignored by the File Reader or the Collecting Process."})
+	r.Register(InformationElement{Name: "minExportSeconds", Pen: 0x0, ID: 0x108, Type: 14, Length: 0x4, Units: "seconds", Description: "The absolute Export Time of the earliest IPFIX Message within the scope containing this Information Element. This Information Element SHOULD be bound to its containing IPFIX Transport Session via an options record and the sessionScope Information Element."})
+	r.Register(InformationElement{Name: "minFlowStartSeconds", Pen: 0x0, ID: 0x109, Type: 14, Length: 0x4, Units: "seconds", Description: "The earliest absolute timestamp of the first packet within any Flow within the scope containing this Information Element, rounded down to the second if necessary. This Information Element SHOULD be bound to its containing IPFIX Transport Session via an options record and the sessionScope Information Element."})
+	r.Register(InformationElement{Name: "opaqueOctets", Pen: 0x0, ID: 0x10a, Type: 0, Length: 0xffff, Description: "This Information Element is used to encapsulate non- IPFIX data into an IPFIX Message stream, for the purpose of allowing a non-IPFIX data processor to store a data stream inline within an IPFIX File. A Collecting Process or File Writer MUST NOT try to interpret this binary data. This Information Element differs from paddingOctets as its contents are meaningful in some non-IPFIX context, while the contents of paddingOctets MUST be 0x00 and are intended only for Information Element alignment."})
+	r.Register(InformationElement{Name: "sessionScope", Pen: 0x0, ID: 0x10b, Type: 1, Length: 0x1, Range: "0-0", Description: "The presence of this Information Element as scope in an Options Template signifies that the options described by the Template apply to the IPFIX Transport Session that contains them. Note that as all options are implicitly scoped to Transport Session and Observation Domain, this Information Element is equivalent to a \"null\" scope. It is defined for general purpose session scoping of options, and proposed specifically to allow the attachment of time window to an IPFIX File via IPFIX Options. The value of this Information Element MUST be written as 0 by the File Writer or Exporting Process. The value of this Information Element MUST be ignored by the File Reader or the Collecting Process."})
+	r.Register(InformationElement{Name: "maxFlowEndMicroseconds", Pen: 0x0, ID: 0x10c, Type: 16, Length: 0x8, Units: "microseconds", Description: "The latest absolute timestamp of the last packet within any Flow within the scope containing this Information Element, rounded up to the microsecond if necessary. This Information Element SHOULD be bound to its containing IPFIX Transport Session via IPFIX Options and the sessionScope Information Element. This Information Element SHOULD be used only in Transport Sessions containing Flow Records with microsecond- precision (or better) timestamp Information Elements."})
+	r.Register(InformationElement{Name: "maxFlowEndMilliseconds", Pen: 0x0, ID: 0x10d, Type: 15, Length: 0x8, Units: "milliseconds", Description: "The latest absolute timestamp of the last packet within any Flow within the scope containing this Information Element, rounded up to the millisecond if necessary. This Information Element SHOULD be bound to its containing IPFIX Transport Session via IPFIX Options and the sessionScope Information Element. This Information Element SHOULD be used only in Transport Sessions containing Flow Records with millisecond- precision (or better) timestamp Information Elements."})
+	r.Register(InformationElement{Name: "maxFlowEndNanoseconds", Pen: 0x0, ID: 0x10e, Type: 17, Length: 0x8, Units: "nanoseconds", Description: "The latest absolute timestamp of the last packet within any Flow within the scope containing this Information Element. This Information Element SHOULD be bound to its containing IPFIX Transport Session via IPFIX Options and the sessionScope Information Element. This Information Element SHOULD be used only in Transport Sessions containing Flow Records with nanosecond-precision timestamp Information Elements."})
+	r.Register(InformationElement{Name: "minFlowStartMicroseconds", Pen: 0x0, ID: 0x10f, Type: 16, Length: 0x8, Units: "microseconds", Description: "The earliest absolute timestamp of the first packet within any Flow within the scope containing this Information Element, rounded down to the microsecond if necessary. This Information Element SHOULD be bound to its containing IPFIX Transport Session via an options record and the sessionScope Information Element. This Information Element SHOULD be used only in Transport Sessions containing Flow Records with microsecond- precision (or better) timestamp Information Elements."})
+	r.Register(InformationElement{Name: "minFlowStartMilliseconds", Pen: 0x0, ID: 0x110, Type: 15, Length: 0x8, Units: "milliseconds", Description: "The earliest absolute timestamp of the first packet within any Flow within the scope containing this Information Element, rounded down to the millisecond if necessary. This Information Element SHOULD be bound to its containing IPFIX Transport Session via an options record and the sessionScope Information Element. This Information Element SHOULD be used only in Transport Sessions containing Flow Records with millisecond- precision (or better) timestamp Information Elements."})
+	r.Register(InformationElement{Name: "minFlowStartNanoseconds", Pen: 0x0, ID: 0x111, Type: 17, Length: 0x8, Units: "nanoseconds", Description: "The earliest absolute timestamp of the first packet within any Flow within the scope containing this Information Element. This Information Element SHOULD be bound to its containing IPFIX Transport Session via an options record and the sessionScope Information Element. This Information Element SHOULD be used only in Transport Sessions containing Flow Records with nanosecond-precision timestamp Information Elements."})
+	r.Register(InformationElement{Name: "collectorCertificate", Pen: 0x0, ID: 0x112, Type: 0, Length: 0xffff, Description: "The full X.509 certificate, encoded in ASN.1 DER format, used by the Collector when IPFIX Messages were transmitted using TLS or DTLS. This Information Element SHOULD be bound to its containing IPFIX Transport Session via an options record and the sessionScope Information Element, or to its containing IPFIX Message via an options record and the messageScope Information Element."})
+	r.Register(InformationElement{Name: "exporterCertificate", Pen: 0x0, ID: 0x113, Type: 0, Length: 0xffff, Description: "The full X.509 certificate, encoded in ASN.1 DER format, used by the Collector when IPFIX Messages were transmitted using TLS or DTLS. This Information Element SHOULD be bound to its containing IPFIX Transport Session via an options record and the sessionScope Information Element, or to its containing IPFIX Message via an options record and the messageScope Information Element."})
+	r.Register(InformationElement{Name: "dataRecordsReliability", Pen: 0x0, ID: 0x114, Type: 11, Length: 0x1, Description: "The export reliability of Data Records, within this SCTP stream, for the element(s) in the Options Template scope. A typical example of an element for which the export reliability will be reported is the templateID, as specified in the Data Records Reliability Options Template. A value of 'True' means that the Exporting Process MUST send any Data Records associated with the element(s) reliably within this SCTP stream. A value of 'False' means that the Exporting Process MAY send any Data Records associated with the element(s) unreliably within this SCTP stream."})
+	r.Register(InformationElement{Name: "observationPointType", Pen: 0x0, ID: 0x115, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "Type of observation point. Values assigned to date are:\n\n1. Physical port\n\n2. Port channel\n\n3. Vlan."})
+	r.Register(InformationElement{Name: "newConnectionDeltaCount", Pen: 0x0, ID: 0x116, Type: 3, Length: 0x4, Semantics: DeltaCounterSemantics, Description: "This information element counts the number of TCP or UDP connections which were opened during the observation period. The observation period may be specified by the flow start and end timestamps."})
+	r.Register(InformationElement{Name: "connectionSumDurationSeconds", Pen: 0x0, ID: 0x117, Type: 4, Length: 0x8, Units: "seconds", Description: "This information element aggregates the total time in seconds for all of the TCP or UDP connections which were in use during the observation period. For example if there are 5 concurrent connections each for 10 seconds, the value would be 50 s."})
+	r.Register(InformationElement{Name: "connectionTransactionId", Pen: 0x0, ID: 0x118, Type: 4, Length: 0x8, Semantics: IdentifierSemantics, Description: "This information element identifies a transaction within a connection. A transaction is a meaningful exchange of application data between two network devices or a client and server. A transactionId is assigned the first time a flow is reported, so that later reports for the same flow will have the same transactionId. A different transactionId is used for each transaction within a TCP or UDP connection. The identifiers need not be sequential."})
+	r.Register(InformationElement{Name: "postNATSourceIPv6Address", Pen: 0x0, ID: 0x119, Type: 19, Length: 0x10, Description: "The definition of this Information Element is identical to the definition of Information Element 'sourceIPv6Address', except that it reports a modified value caused by a NAT64 middlebox function after the packet passed the Observation Point. See [RFC8200] for the definition of the Source Address field in the IPv6 header. See [RFC3234] for the definition of middleboxes. See [RFC6146] for nat64 specification."})
+	r.Register(InformationElement{Name: "postNATDestinationIPv6Address", Pen: 0x0, ID: 0x11a, Type: 19, Length: 0x10, Description: "The definition of this Information Element is identical to the definition of Information Element 'destinationIPv6Address', except that it reports a modified value caused by a NAT64 middlebox function after the packet passed the Observation Point. See [RFC8200] for the definition of the Destination Address field in the IPv6 header. See [RFC3234] for the definition of middleboxes. See [RFC6146] for nat64 specification."})
+	r.Register(InformationElement{Name: "natPoolId", Pen: 0x0, ID: 0x11b, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "Locally unique identifier of a NAT pool."})
+	r.Register(InformationElement{Name: "natPoolName", Pen: 0x0, ID: 0x11c, Type: 13, Length: 0xffff, Description: "The name of a NAT pool identified by a natPoolID."})
+	r.Register(InformationElement{Name: "anonymizationFlags", Pen: 0x0, ID: 0x11d, Type: 2, Length: 0x2, Semantics: FlagsSemantics, Description: "A flag word describing specialized modifications to the anonymization policy in effect for the anonymization technique applied to a referenced Information Element within a referenced Template. When flags are clear (0), the normal policy (as described by anonymizationTechnique) applies without modification.\n\nMSB   14  13  12  11  10   9   8   7   6   5   4   3   2   1  LSB\n+---+---+---+---+---+---+---+---+---+---+---+---+---+---+---+---+\n|                Reserved                       |LOR|PmA|   SC  |\n+---+---+---+---+---+---+---+---+---+---+---+---+---+---+---+---+\n\nanonymizationFlags IE\n\n+--------+----------+-----------------------------------------------+\n| bit(s) | name     | description                                   |\n| (LSB = |          |                                               |\n| 0)     |          |                                               |\n+--------+----------+-----------------------------------------------+\n| 0-1    | SC       | Stability Class: see the Stability Class      |\n|        |          | table below, and section Section 5.1.         |\n| 2      | PmA      | Perimeter Anonymization: when set (1),        |\n|        |          | source- Information Elements as described in  |\n|        |          | [RFC5103] are interpreted as external         |\n|        |          | addresses, and destination- Information       |\n|        |          | Elements as described in [RFC5103] are        |\n|        |          | interpreted as internal addresses, for the    |\n|        |          | purposes of associating                       |\n|        |          | anonymizationTechnique to Information         |\n|        |          | Elements only; see Section 7.2.2 for details. |\n|        |          | This bit MUST NOT be set when associated with |\n|        |          | a non-endpoint (i.e., source- or              |\n|        |          | destination-) Information Element.  SHOULD be |\n|        |          | consistent within a record (i.e., if a        |\n|        |          | source- Information Element has this flag     |\n|        |          | set, the corresponding destination- element   |\n|        |          | SHOULD have this flag set, and vice-versa.)   |\n| 3      | LOR      | Low-Order Unchanged: when set (1), the        |\n|        |          | low-order bits of the anonymized Information  |\n|        |          | Element contain real data.  This modification |\n|        |          | is intended for the anonymization of          |\n|        |          | network-level addresses while leaving         |\n|        |          | host-level addresses intact in order to       |\n|        |          | preserve host level-structure, which could    |\n|        |          | otherwise be used to reverse anonymization.   |\n|        |          | MUST NOT be set when associated with a        |\n|        |          | truncation-based anonymizationTechnique.      |\n| 4-15   | Reserved | Reserved for future use: SHOULD be cleared    |\n|        |          | (0) by the Exporting Process and MUST be      |\n|        |          | ignored by the Collecting Process.            |\n+--------+----------+-----------------------------------------------+\n\nThe Stability Class portion of this flags word describes the stability class of the anonymization technique applied to a referenced Information Element within a referenced Template. Stability classes refer to the stability of the parameters of the anonymization technique, and therefore the comparability of the mapping between the real and anonymized values over time. This determines which anonymized datasets may be compared with each other. Values are as follows:\n\n+-----+-----+-------------------------------------------------------+\n| Bit | Bit | Description                                           |\n| 1   | 0   |                                                       |\n+-----+-----+-------------------------------------------------------+\n| 0   | 0   | Undefined: the Exporting Process makes no             |\n|     |     | representation as to how stable the mapping is, or    |\n|     |     | over what time period values of this field will       |\n|     |     | remain comparable; while the Collecting Process MAY   |\n|     |     | assume Session level stability, Session level         |\n|     |     | stability is not guaranteed.  Processes SHOULD assume |\n|     |     | this is the case in the absence of stability class    |\n|     |     | information; this is the default stability class.     |\n| 0   | 1   | Session: the Exporting Process will ensure that the   |\n|     |     | parameters of the anonymization technique are stable  |\n|     |     | during the Transport Session.  All the values of the  |\n|     |     | described Information Element for each Record         |\n|     |     | described by the referenced Template within the       |\n|     |     | Transport Session are comparable.  The Exporting      |\n|     |     | Process SHOULD endeavour to ensure at least this      |\n|     |     | stability class.                                      |\n| 1   | 0   | Exporter-Collector Pair: the Exporting Process will   |\n|     |     | ensure that the parameters of the anonymization       |\n|     |     | technique are stable across Transport Sessions over   |\n|     |     | time with the given Collecting Process, but may use   |\n|     |     | different parameters for different Collecting         |\n|     |     | Processes.  Data exported to different Collecting     |\n|     |     | Processes are not comparable.                         |\n| 1   | 1   | Stable: the Exporting Process will ensure that the    |\n|     |     | parameters of the anonymization technique are stable  |\n|     |     | across Transport Sessions over time, regardless of    |\n|     |     | the Collecting Process to which it is sent.           |\n+-----+-----+-------------------------------------------------------+"})
+	r.Register(InformationElement{Name: "anonymizationTechnique", Pen: 0x0, ID: 0x11e, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "A description of the anonymization technique applied to a referenced Information Element within a referenced Template. Each technique may be applicable only to certain Information Elements and recommended only for certain Infomation Elements; these restrictions are noted in the table below.\n\n+-------+---------------------------+-----------------+-------------+\n| Value | Description               | Applicable to   | Recommended |\n|       |                           |                 | for         |\n+-------+---------------------------+-----------------+-------------+\n| 0     | Undefined: the Exporting  | all             | all         |\n|       | Process makes no          |                 |             |\n|       | representation as to      |                 |             |\n|       | whether the defined field |                 |             |\n|       | is anonymized or not.     |                 |             |\n|       | While the Collecting      |                 |             |\n|       | Process MAY assume that   |                 |             |\n|       | the field is not          |                 |             |\n|       | anonymized, it is not     |                 |             |\n|       | guaranteed not to be.     |                 |             |\n|       | This is the default       |                 |             |\n|       | anonymization technique.  |                 |             |\n| 1     | None: the values exported | all             | all         |\n|       | are real.                 |                 |             |\n| 2     | Precision                 | all             | all         |\n|       | Degradation/Truncation:   |                 |             |\n|       | the values exported are   |                 |             |\n|       | anonymized using simple   |                 |             |\n|       | precision degradation or  |                 |             |\n|       | truncation.  The new      |                 |             |\n|       | precision or number of    |                 |             |\n|       | truncated bits is         |                 |             |\n|       | implicit in the exported  |                 |             |\n|       | data, and can be deduced  |                 |             |\n|       | by the Collecting         |                 |             |\n|       | Process.                  |                 |             |\n| 3     | Binning: the values       | all             | all         |\n|       | exported are anonymized   |                 |             |\n|       | into bins.                |                 |             |\n| 4     | Enumeration: the values   | all             | timestamps  |\n|       | exported are anonymized   |                 |             |\n|       | by enumeration.           |                 |             |\n| 5     | Permutation: the values   | all             | identifiers |\n|       | exported are anonymized   |                 |             |\n|       | by permutation.           |                 |             |\n| 6     | Structured Permutation:   | addresses       |             |\n|       | the values exported are   |                 |             |\n|       | anonymized by             |                 |             |\n|       | permutation, preserving   |                 |             |\n|       | bit-level structure as    |                 |             |\n|       | appropriate; this         |                 |             |\n|       | represents                |                 |             |\n|       | prefix-preserving IP      |                 |             |\n|       | address anonymization or  |                 |             |\n|       | structured MAC address    |                 |             |\n|       | anonymization.            |                 |             |\n| 7     | Reverse Truncation: the   | addresses       |             |\n|       | values exported are       |                 |             |\n|       | anonymized using reverse  |                 |             |\n|       | truncation.  The number   |                 |             |\n|       | of truncated bits is      |                 |             |\n|       | implicit in the exported  |                 |             |\n|       | data, and can be deduced  |                 |             |\n|       | by the Collecting         |                 |             |\n|       | Process.                  |                 |             |\n| 8     | Noise: the values         | non-identifiers | counters    |\n|       | exported are anonymized   |                 |             |\n|       | by adding random noise to |                 |             |\n|       | each value.               |                 |             |\n| 9     | Offset: the values        | all             | timestamps  |\n|       | exported are anonymized   |                 |             |\n|       | by adding a single offset |                 |             |\n|       | to all values.            |                 |             |\n+-------+---------------------------+-----------------+-------------+"})
+	r.Register(InformationElement{Name: "informationElementIndex", Pen: 0x0, ID: 0x11f, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "A zero-based index of an Information Element referenced by informationElementId within a Template referenced by templateId; used to disambiguate scope for templates containing multiple identical Information Elements."})
+	r.Register(InformationElement{Name: "p2pTechnology", Pen: 0x0, ID: 0x120, Type: 13, Length: 0xffff, Description: "Specifies if the Application ID is based on peer-to-peer technology.\n\nPossible values are: { \"yes\", \"y\", 1 }, { \"no\", \"n\", 2 } and { \"unassigned\", \"u\", 0 }."})
+	r.Register(InformationElement{Name: "tunnelTechnology", Pen: 0x0, ID: 0x121, Type: 13, Length: 0xffff, Description: "Specifies if the Application ID is used as a tunnel technology.\n\nPossible values are: { \"yes\", \"y\", 1 }, { \"no\", \"n\", 2 } and { \"unassigned\", \"u\", 0 }."})
+	r.Register(InformationElement{Name: "encryptedTechnology", Pen: 0x0, ID: 0x122, Type: 13, Length: 0xffff, Description: "Specifies if the Application ID is an encrypted networking protocol.\n\nPossible values are: { \"yes\", \"y\", 1 }, { \"no\", \"n\", 2 } and { \"unassigned\", \"u\", 0 }."})
+	r.Register(InformationElement{Name: "basicList", Pen: 0x0, ID: 0x123, Type: 20, Length: 0xffff, Semantics: ListSemantics, Description: "Specifies a generic Information Element with a basicList abstract data type. For example, a list of port numbers, a list of interface indexes, etc."})
+	r.Register(InformationElement{Name: "subTemplateList", Pen: 0x0, ID: 0x124, Type: 21, Length: 0xffff, Semantics: ListSemantics, Description: "Specifies a generic Information Element with a subTemplateList abstract data type."})
+	r.Register(InformationElement{Name: "subTemplateMultiList", Pen: 0x0, ID: 0x125, Type: 22, Length: 0xffff, Semantics: ListSemantics, Description: "Specifies a generic Information Element with a subTemplateMultiList abstract data type."})
+	r.Register(InformationElement{Name: "bgpValidityState", Pen: 0x0, ID: 0x126, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "This element describes the \"validity state\" of the BGP route correspondent source or destination IP address. If the \"validity state\" for this Flow is only available, then the value of this Information Element is 255."})
+	r.Register(InformationElement{Name: "IPSecSPI", Pen: 0x0, ID: 0x127, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "IPSec Security Parameters Index (SPI)."})
+	r.Register(InformationElement{Name: "greKey", Pen: 0x0, ID: 0x128, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "GRE key, which is used for identifying an individual traffic flow within a tunnel."})
+	r.Register(InformationElement{Name: "natType", Pen: 0x0, ID: 0x129, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "The type of NAT treatment:\n\n0 unknown\n\n1 NAT44 translated\n\n2 NAT64 translated\n\n3 NAT46 translated\n\n4 IPv4-->IPv4 (no NAT)\n\n5 NAT66 translated\n\n6 IPv6-->IPv6 (no NAT)"})
+	r.Register(InformationElement{Name: "initiatorPackets", Pen: 0x0, ID: 0x12a, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "packets", Description: "The total number of layer 4 packets in a flow from the initiator since the previous report. The initiator is the device which triggered the session creation, and remains the same for the life of the session."})
+	r.Register(InformationElement{Name: "responderPackets", Pen: 0x0, ID: 0x12b, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "packets", Description: "The total number of layer 4 packets in a flow from the responder since the previous report. The responder is the device which replies to the initiator, and remains the same for the life of the session."})
+	r.Register(InformationElement{Name: "observationDomainName", Pen: 0x0, ID: 0x12c, Type: 13, Length: 0xffff, Description: "The name of an observation domain identified by an observationDomainId."})
+	r.Register(InformationElement{Name: "selectionSequenceId", Pen: 0x0, ID: 0x12d, Type: 4, Length: 0x8, Semantics: IdentifierSemantics, Description: "From all the packets observed at an Observation Point, a subset of the packets is selected by a sequence of one or more Selectors. The selectionSequenceId is a unique value per Observation Domain, specifying the Observation Point and the sequence of Selectors through which the packets are selected."})
+	r.Register(InformationElement{Name: "selectorId", Pen: 0x0, ID: 0x12e, Type: 4, Length: 0x8, Semantics: IdentifierSemantics, Description: "The Selector ID is the unique ID identifying a Primitive Selector. Each Primitive Selector must have a unique ID in the Observation Domain."})
+	r.Register(InformationElement{Name: "informationElementId", Pen: 0x0, ID: 0x12f, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "This Information Element contains the ID of another Information Element."})
+	r.Register(InformationElement{Name: "selectorAlgorithm", Pen: 0x0, ID: 0x130, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "This Information Element identifies the packet selection methods (e.g., Filtering, Sampling) that are applied by the Selection Process.\n\nMost of these methods have parameters. Further Information Elements are needed to fully specify packet selection with these methods and all their parameters.\n\nThe methods listed below are defined in [RFC5475]. For their parameters, Information Elements are defined in the information model document. The names of these Information Elements are listed for each method identifier.\n\nFurther method identifiers may be added to the list below. It might be necessary to define new Information Elements to specify their parameters.\n\nThe selectorAlgorithm registry is maintained by IANA. New assignments for the registry will be administered by IANA, and are subject to Expert Review [RFC8126].\n\nThe registry can be updated when specifications of the new method(s) and any new Information Elements are provided.\n\nThe group of experts must double check the selectorAlgorithm definitions and Information Elements with already defined selectorAlgorithms and Information Elements for completeness, accuracy, and redundancy. Those experts will initially be drawn from the Working Group Chairs and document editors of the IPFIX and PSAMP Working Groups.\n\nThe following packet selection methods identifiers are defined here:\n\n[psamp-parameters]\n\nThere is a broad variety of possible parameters that could be used for Property match Filtering (5) but currently there are no agreed parameters specified."})
+	r.Register(InformationElement{Name: "samplingPacketInterval", Pen: 0x0, ID: 0x131, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "packets", Description: "This Information Element specifies the number of packets that are consecutively sampled. A value of 100 means that 100 consecutive packets are sampled.\n\nFor example, this Information Element may be used to describe the configuration of a systematic count-based Sampling Selector."})
+	r.Register(InformationElement{Name: "samplingPacketSpace", Pen: 0x0, ID: 0x132, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "packets", Description: "This Information Element specifies the number of packets between two \"samplingPacketInterval\"s. A value of 100 means that the next interval starts 100 packets (which are not sampled) after the current \"samplingPacketInterval\" is over.\n\nFor example, this Information Element may be used to describe the configuration of a systematic count-based Sampling Selector."})
+	r.Register(InformationElement{Name: "samplingTimeInterval", Pen: 0x0, ID: 0x133, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "microseconds", Description: "This Information Element specifies the time interval in microseconds during which all arriving packets are sampled.\n\nFor example, this Information Element may be used to describe the configuration of a systematic time-based Sampling Selector."})
+	r.Register(InformationElement{Name: "samplingTimeSpace", Pen: 0x0, ID: 0x134, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "microseconds", Description: "This Information Element specifies the time interval in microseconds between two \"samplingTimeInterval\"s. A value of 100 means that the next interval starts 100 microseconds (during which no packets are sampled) after the current \"samplingTimeInterval\" is over.\n\nFor example, this Information Element may used to describe the configuration of a systematic time-based Sampling Selector."})
+	r.Register(InformationElement{Name: "samplingSize", Pen: 0x0, ID: 0x135, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "packets", Description: "This Information Element specifies the number of elements taken from the parent Population for random Sampling methods.\n\nFor example, this Information Element may be used to describe the configuration of a random n-out-of-N Sampling Selector."})
+	r.Register(InformationElement{Name: "samplingPopulation", Pen: 0x0, ID: 0x136, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "packets", Description: "This Information Element specifies the number of elements in the parent Population for random Sampling methods.\n\nFor example, this Information Element may be used to describe the configuration of a random n-out-of-N Sampling Selector."})
+	r.Register(InformationElement{Name: "samplingProbability", Pen: 0x0, ID: 0x137, Type: 10, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the probability that a packet is sampled, expressed as a value between 0 and 1. The probability is equal for every packet. A value of 0 means no packet was sampled since the probability is 0.\n\nFor example, this Information Element may be used to describe the configuration of a uniform probabilistic Sampling Selector."})
+	r.Register(InformationElement{Name: "dataLinkFrameSize", Pen: 0x0, ID: 0x138, Type: 2, Length: 0x2, Semantics: QuantitySemantics, Description: "This Information Element specifies the length of the selected data link frame.\n\nThe data link layer is defined in [ISO/IEC.7498-1:1994]."})
+	r.Register(InformationElement{Name: "ipHeaderPacketSection", Pen: 0x0, ID: 0x139, Type: 0, Length: 0xffff, Description: "This Information Element carries a series of n octets from the IP header of a sampled packet, starting sectionOffset octets into the IP header.\n\nHowever, if no sectionOffset field corresponding to this Information Element is present, then a sectionOffset of zero applies, and the octets MUST be from the start of the IP header.\n\nWith sufficient length, this element also reports octets from the IP payload. However, full packet capture of arbitrary packet streams is explicitly out of scope per the Security Considerations sections of [RFC5477] and [RFC2804].\n\nThe sectionExportedOctets expresses how much data was exported, while the remainder is padding.\n\nWhen the sectionExportedOctets field corresponding to this Information Element exists, this Information Element MAY have a fixed length and MAY be padded, or it MAY have a variable length.\n\nWhen the sectionExportedOctets field corresponding to this Information Element does not exist, this Information Element SHOULD have a variable length and MUST NOT be padded. In this case, the size of the exported section may be constrained due to limitations in the IPFIX protocol."})
+	r.Register(InformationElement{Name: "ipPayloadPacketSection", Pen: 0x0, ID: 0x13a, Type: 0, Length: 0xffff, Description: "This Information Element carries a series of n octets from the IP payload of a sampled packet, starting sectionOffset octets into the IP payload.\n\nHowever, if no sectionOffset field corresponding to this Information Element is present, then a sectionOffset of zero applies, and the octets MUST be from the start of the IP payload.\n\nThe IPv4 payload is that part of the packet that follows the IPv4 header and any options, which [RFC791] refers to as \"data\" or \"data octets\". For example, see the examples in [RFC791], Appendix A.\n\nThe IPv6 payload is the rest of the packet following the 40-octet IPv6 header. Note that any extension headers present are considered part of the payload. See [RFC8200] for the IPv6 specification.\n\nThe sectionExportedOctets expresses how much data was observed, while the remainder is padding.\n\nWhen the sectionExportedOctets field corresponding to this Information Element exists, this Information Element MAY have a fixed length and MAY be padded, or MAY have a variable length.\n\nWhen the sectionExportedOctets field corresponding to this Information Element does not exist, this Information Element SHOULD have a variable length and MUST NOT be padded. In this case, the size of the exported section may be constrained due to limitations in the IPFIX protocol."})
+	r.Register(InformationElement{Name: "dataLinkFrameSection", Pen: 0x0, ID: 0x13b, Type: 0, Length: 0xffff, Description: "This Information Element carries n octets from the data link frame of a selected frame, starting sectionOffset octets into the frame.\n\nHowever, if no sectionOffset field corresponding to this Information Element is present, then a sectionOffset of zero applies, and the octets MUST be from the start of the data link frame.\n\nThe sectionExportedOctets expresses how much data was observed, while the remainder is padding.\n\nWhen the sectionExportedOctets field corresponding to this Information Element exists, this Information Element MAY have a fixed length and MAY be padded, or MAY have a variable length.\n\nWhen the sectionExportedOctets field corresponding to this Information Element does not exist, this Information Element SHOULD have a variable length and MUST NOT be padded. In this case, the size of the exported section may be constrained due to limitations in the IPFIX protocol.\n\nFurther Information Elements, i.e., dataLinkFrameType and dataLinkFrameSize, are needed to specify the data link type and the size of the data link frame of this Information Element. A set of these Information Elements MAY be contained in a structured data type, as expressed in [RFC6313]. Or a set of these Information Elements MAY be contained in one Flow Record as shown in Appendix B of [RFC7133].\n\nThe data link layer is defined in [ISO/IEC.7498-1:1994]."})
+	r.Register(InformationElement{Name: "mplsLabelStackSection", Pen: 0x0, ID: 0x13c, Type: 0, Length: 0xffff, Description: "This Information Element carries a series of n octets from the MPLS label stack of a sampled packet, starting sectionOffset octets into the MPLS label stack.\n\nHowever, if no sectionOffset field corresponding to this Information Element is present, then a sectionOffset of zero applies, and the octets MUST be from the head of the MPLS label stack.\n\nWith sufficient length, this element also reports octets from the MPLS payload. However, full packet capture of arbitrary packet streams is explicitly out of scope per the Security Considerations sections of [RFC5477] and [RFC2804].\n\nSee [RFC3031] for the specification of MPLS packets.\n\nSee [RFC3032] for the specification of the MPLS label stack.\n\nThe sectionExportedOctets expresses how much data was observed, while the remainder is padding.\n\nWhen the sectionExportedOctets field corresponding to this Information Element exists, this Information Element MAY have a fixed length and MAY be padded, or MAY have a variable length.\n\nWhen the sectionExportedOctets field corresponding to this Information Element does not exist, this Information Element SHOULD have a variable length and MUST NOT be padded. In this case, the size of the exported section may be constrained due to limitations in the IPFIX protocol."})
+	r.Register(InformationElement{Name: "mplsPayloadPacketSection", Pen: 0x0, ID: 0x13d, Type: 0, Length: 0xffff, Description: "The mplsPayloadPacketSection carries a series of n octets from the MPLS payload of a sampled packet, starting sectionOffset octets into the MPLS payload, as it is data that follows immediately after the MPLS label stack.\n\nHowever, if no sectionOffset field corresponding to this Information Element is present, then a sectionOffset of zero applies, and the octets MUST be from the start of the MPLS payload.\n\nSee [RFC3031] for the specification of MPLS packets.\n\nSee [RFC3032] for the specification of the MPLS label stack.\n\nThe sectionExportedOctets expresses how much data was observed, while the remainder is padding.\n\nWhen the sectionExportedOctets field corresponding to this Information Element exists, this Information Element MAY have a fixed length and MAY be padded, or it MAY have a variable length.\n\nWhen the sectionExportedOctets field corresponding to this Information Element does not exist, this Information Element SHOULD have a variable length and MUST NOT be padded. In this case, the size of the exported section may be constrained due to limitations in the IPFIX protocol."})
+	r.Register(InformationElement{Name: "selectorIdTotalPktsObserved", Pen: 0x0, ID: 0x13e, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "This Information Element specifies the total number of packets observed by a Selector, for a specific value of SelectorId.\n\nThis Information Element should be used in an Options Template scoped to the observation to which it refers. See Section 3.4.2.1 of the IPFIX protocol document [RFC7011]."})
+	r.Register(InformationElement{Name: "selectorIdTotalPktsSelected", Pen: 0x0, ID: 0x13f, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "This Information Element specifies the total number of packets selected by a Selector, for a specific value of SelectorId.\n\nThis Information Element should be used in an Options Template scoped to the observation to which it refers. See Section 3.4.2.1 of the IPFIX protocol document [RFC7011]."})
+	r.Register(InformationElement{Name: "absoluteError", Pen: 0x0, ID: 0x140, Type: 10, Length: 0x8, Semantics: QuantitySemantics, Units: "inferred", Description: "This Information Element specifies the maximum possible measurement error of the reported value for a given Information Element. The absoluteError has the same unit as the Information Element with which it is associated. The real value of the metric can differ by absoluteError (positive or negative) from the measured value.\n\nThis Information Element provides only the error for measured values. If an Information Element contains an estimated value (from Sampling), the confidence boundaries and confidence level have to be provided instead, using the upperCILimit, lowerCILimit, and confidenceLevel Information Elements.\n\nThis Information Element should be used in an Options Template scoped to the observation to which it refers. See Section 3.4.2.1 of the IPFIX protocol document [RFC7011]."})
+	r.Register(InformationElement{Name: "relativeError", Pen: 0x0, ID: 0x141, Type: 10, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the maximum possible positive or negative error ratio for the reported value for a given Information Element as percentage of the measured value. The real value of the metric can differ by relativeError percent (positive or negative) from the measured value.\n\nThis Information Element provides only the error for measured values. If an Information Element contains an estimated value (from Sampling), the confidence boundaries and confidence level have to be provided instead, using the upperCILimit, lowerCILimit, and confidenceLevel Information Elements.\n\nThis Information Element should be used in an Options Template scoped to the observation to which it refers. See Section 3.4.2.1 of the IPFIX protocol document [RFC7011]."})
+	r.Register(InformationElement{Name: "observationTimeSeconds", Pen: 0x0, ID: 0x142, Type: 14, Length: 0x4, Units: "seconds", Description: "This Information Element specifies the absolute time in seconds of an observation."})
+	r.Register(InformationElement{Name: "observationTimeMilliseconds", Pen: 0x0, ID: 0x143, Type: 15, Length: 0x8, Units: "milliseconds", Description: "This Information Element specifies the absolute time in milliseconds of an observation."})
+	r.Register(InformationElement{Name: "observationTimeMicroseconds", Pen: 0x0, ID: 0x144, Type: 16, Length: 0x8, Units: "microseconds", Description: "This Information Element specifies the absolute time in microseconds of an observation."})
+	r.Register(InformationElement{Name: "observationTimeNanoseconds", Pen: 0x0, ID: 0x145, Type: 17, Length: 0x8, Units: "nanoseconds", Description: "This Information Element specifies the absolute time in nanoseconds of an observation."})
+	r.Register(InformationElement{Name: "digestHashValue", Pen: 0x0, ID: 0x146, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the value from the digest hash function. See also Sections 6.2, 3.8 and 7.1 of [RFC5475]."})
+	r.Register(InformationElement{Name: "hashIPPayloadOffset", Pen: 0x0, ID: 0x147, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the IP payload offset used by a Hash-based Selection Selector. See also Sections 6.2, 3.8 and 7.1 of [RFC5475]."})
+	r.Register(InformationElement{Name: "hashIPPayloadSize", Pen: 0x0, ID: 0x148, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the IP payload size used by a Hash-based Selection Selector. See also Sections 6.2, 3.8 and 7.1 of [RFC5475]."})
+	r.Register(InformationElement{Name: "hashOutputRangeMin", Pen: 0x0, ID: 0x149, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the value for the beginning of a hash function's potential output range.\n\nSee also Sections 6.2, 3.8 and 7.1 of [RFC5475]."})
+	r.Register(InformationElement{Name: "hashOutputRangeMax", Pen: 0x0, ID: 0x14a, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the value for the end of a hash function's potential output range.\n\nSee also Sections 6.2, 3.8 and 7.1 of [RFC5475]."})
+	r.Register(InformationElement{Name: "hashSelectedRangeMin", Pen: 0x0, ID: 0x14b, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the value for the beginning of a hash function's selected range.\n\nSee also Sections 6.2, 3.8 and 7.1 of [RFC5475]."})
+	r.Register(InformationElement{Name: "hashSelectedRangeMax", Pen: 0x0, ID: 0x14c, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the value for the end of a hash function's selected range.\n\nSee also Sections 6.2, 3.8 and 7.1 of [RFC5475]."})
+	r.Register(InformationElement{Name: "hashDigestOutput", Pen: 0x0, ID: 0x14d, Type: 11, Length: 0x1, Description: "This Information Element contains a boolean value that is TRUE if the output from this hash Selector has been configured to be included in the packet report as a packet digest, else FALSE.\n\nSee also Sections 6.2, 3.8 and 7.1 of [RFC5475]."})
+	r.Register(InformationElement{Name: "hashInitialiserValue", Pen: 0x0, ID: 0x14e, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the initialiser value to the hash function.\n\nSee also Sections 6.2, 3.8 and 7.1 of [RFC5475]."})
+	r.Register(InformationElement{Name: "selectorName", Pen: 0x0, ID: 0x14f, Type: 13, Length: 0xffff, Description: "The name of a selector identified by a selectorID. Globally unique per Metering Process."})
+	r.Register(InformationElement{Name: "upperCILimit", Pen: 0x0, ID: 0x150, Type: 10, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the upper limit of a confidence interval. It is used to provide an accuracy statement for an estimated value. The confidence limits define the range in which the real value is assumed to be with a certain probability p. Confidence limits always need to be associated with a confidence level that defines this probability p. Please note that a confidence interval only provides a probability that the real value lies within the limits. That means the real value can lie outside the confidence limits.\n\nThe upperCILimit, lowerCILimit, and confidenceLevel Information Elements should all be used in an Options Template scoped to the observation to which they refer. See Section 3.4.2.1 of the IPFIX protocol document [RFC7011].\n\nNote that the upperCILimit, lowerCILimit, and confidenceLevel are all required to specify confidence, and should be disregarded unless all three are specified together."})
+	r.Register(InformationElement{Name: "lowerCILimit", Pen: 0x0, ID: 0x151, Type: 10, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the lower limit of a confidence interval. For further information, see the description of upperCILimit.\n\nThe upperCILimit, lowerCILimit, and confidenceLevel Information Elements should all be used in an Options Template scoped to the observation to which they refer. See Section 3.4.2.1 of the IPFIX protocol document [RFC7011].\n\nNote that the upperCILimit, lowerCILimit, and confidenceLevel are all required to specify confidence, and should be disregarded unless all three are specified together."})
+	r.Register(InformationElement{Name: "confidenceLevel", Pen: 0x0, ID: 0x152, Type: 10, Length: 0x8, Semantics: QuantitySemantics, Description: "This Information Element specifies the confidence level. It is used to provide an accuracy statement for estimated values. The confidence level provides the probability p with which the real value lies within a given range. A confidence level always needs to be associated with confidence limits that define the range in which the real value is assumed to be.\n\nThe upperCILimit, lowerCILimit, and confidenceLevel Information Elements should all be used in an Options Template scoped to the observation to which they refer. See Section 3.4.2.1 of the IPFIX protocol document [RFC7011].\n\nNote that the upperCILimit, lowerCILimit, and confidenceLevel are all required to specify confidence, and should be disregarded unless all three are specified together."})
+	r.Register(InformationElement{Name: "informationElementDataType", Pen: 0x0, ID: 0x153, Type: 1, Length: 0x1, Description: "A description of the abstract data type of an IPFIX information element.These are taken from the abstract data types defined in section 3.1 of the IPFIX Information Model [RFC5102]; see that section for more information on the types described in the informationElementDataType sub-registry.\n\nThese types are registered in the IANA IPFIX Information Element Data Type subregistry. This subregistry is intended to assign numbers for type names, not to provide a mechanism for adding data types to the IPFIX Protocol, and as such requires a Standards Action [RFC8126] to modify."})
+	r.Register(InformationElement{Name: "informationElementDescription", Pen: 0x0, ID: 0x154, Type: 13, Length: 0xffff, Description: "A UTF-8 [RFC3629] encoded Unicode string containing a human-readable description of an Information Element. The content of the informationElementDescription MAY be annotated with one or more language tags [RFC4646], encoded in-line [RFC2482] within the UTF-8 string, in order to specify the language in which the description is written. Description text in multiple languages MAY tag each section with its own language tag; in this case, the description information in each language SHOULD have equivalent meaning. In the absence of any language tag, the \"i-default\" [RFC2277] language SHOULD be assumed. See the Security Considerations section for notes on string handling for Information Element type records."})
+	r.Register(InformationElement{Name: "informationElementName", Pen: 0x0, ID: 0x155, Type: 13, Length: 0xffff, Description: "A UTF-8 [RFC3629] encoded Unicode string containing the name of an Information Element, intended as a simple identifier. See the Security Considerations section for notes on string handling for Information Element type records"})
+	r.Register(InformationElement{Name: "informationElementRangeBegin", Pen: 0x0, ID: 0x156, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "Contains the inclusive low end of the range of acceptable values for an Information Element."})
+	r.Register(InformationElement{Name: "informationElementRangeEnd", Pen: 0x0, ID: 0x157, Type: 4, Length: 0x8, Semantics: QuantitySemantics, Description: "Contains the inclusive high end of the range of acceptable values for an Information Element."})
+	r.Register(InformationElement{Name: "informationElementSemantics", Pen: 0x0, ID: 0x158, Type: 1, Length: 0x1, Description: "A description of the semantics of an IPFIX Information Element. These are taken from the data type semantics defined in section 3.2 of the IPFIX Information Model [RFC5102]; see that section for more information on the types defined in the informationElementSemantics sub-registry. This field may take the values in Table ; the special value 0x00 (default) is used to note that no semantics apply to the field; it cannot be manipulated by a Collecting Process or File Reader that does not understand it a priori.\n\nThese semantics are registered in the IANA IPFIX Information Element Semantics subregistry. This subregistry is intended to assign numbers for semantics names, not to provide a mechanism for adding semantics to the IPFIX Protocol, and as such requires a Standards Action [RFC8126] to modify."})
+	r.Register(InformationElement{Name: "informationElementUnits", Pen: 0x0, ID: 0x159, Type: 2, Length: 0x2, Description: "A description of the units of an IPFIX Information Element. These correspond to the units implicitly defined in the Information Element definitions in section 5 of the IPFIX Information Model [RFC5102]; see that section for more information on the types described in the informationElementsUnits sub-registry. This field may take the values in Table 3 below; the special value 0x00 (none) is used to note that the field is unitless.\n\nThese types are registered in the IANA IPFIX Information Element Units subregistry; new types may be added on a First Come First Served [RFC8126] basis."})
+	r.Register(InformationElement{Name: "privateEnterpriseNumber", Pen: 0x0, ID: 0x15a, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "A private enterprise number, as assigned by IANA. Within the context of an Information Element Type record, this element can be used along with the informationElementId element to scope properties to a specific Information Element. To export type information about an IANA-assigned Information Element, set the privateEnterpriseNumber to 0, or do not export the privateEnterpriseNumber in the type record. To export type information about an enterprise-specific Information Element, export the enterprise number in privateEnterpriseNumber, and export the Information Element number with the Enterprise bit cleared in informationElementId. The Enterprise bit in the associated informationElementId Information Element MUST be ignored by the Collecting Process."})
+	r.Register(InformationElement{Name: "virtualStationInterfaceId", Pen: 0x0, ID: 0x15b, Type: 0, Length: 0xffff, Description: "Instance Identifier of the interface to a Virtual Station. A Virtual Station is an end station instance: it can be a virtual machine or a physical host."})
+	r.Register(InformationElement{Name: "virtualStationInterfaceName", Pen: 0x0, ID: 0x15c, Type: 13, Length: 0xffff, Description: "Name of the interface to a Virtual Station. A Virtual Station is an end station instance: it can be a virtual machine or a physical host."})
+	r.Register(InformationElement{Name: "virtualStationUUID", Pen: 0x0, ID: 0x15d, Type: 0, Length: 0xffff, Description: "Unique Identifier of a Virtual Station. A Virtual Station is an end station instance: it can be a virtual machine or a physical host."})
+	r.Register(InformationElement{Name: "virtualStationName", Pen: 0x0, ID: 0x15e, Type: 13, Length: 0xffff, Description: "Name of a Virtual Station. A Virtual Station is an end station instance: it can be a virtual machine or a physical host."})
+	r.Register(InformationElement{Name: "layer2SegmentId", Pen: 0x0, ID: 0x15f, Type: 4, Length: 0x8, Semantics: IdentifierSemantics, Description: "Identifier of a layer 2 network segment in an overlay network. The most significant byte identifies the layer 2 network overlay network encapsulation type:\n\n0x00 reserved\n\n0x01 VxLAN\n\n0x02 NVGRE\n\nThe three lowest significant bytes hold the value of the layer 2 overlay network segment identifier.\n\nFor example:\n\n- a 24 bit segment ID VXLAN Network Identifier (VNI)\n\n- a 24 bit Tenant Network Identifier (TNI) for NVGRE"})
+	r.Register(InformationElement{Name: "layer2OctetDeltaCount", Pen: 0x0, ID: 0x160, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The number of layer 2 octets since the previous report (if any) in incoming packets for this Flow at the Observation Point. The number of octets includes layer 2 header(s) and layer 2 payload. # memo: layer 2 version of octetDeltaCount (field #1)"})
+	r.Register(InformationElement{Name: "layer2OctetTotalCount", Pen: 0x0, ID: 0x161, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of layer 2 octets in incoming packets for this Flow at the Observation Point since the Metering Process (re-)initialization for this Observation Point. The number of octets includes layer 2 header(s) and layer 2 payload. # memo: layer 2 version of octetTotalCount (field #85)"})
+	r.Register(InformationElement{Name: "ingressUnicastPacketTotalCount", Pen: 0x0, ID: 0x162, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of incoming unicast packets metered at the Observation Point since the Metering Process (re-)initialization for this Observation Point."})
+	r.Register(InformationElement{Name: "ingressMulticastPacketTotalCount", Pen: 0x0, ID: 0x163, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of incoming multicast packets metered at the Observation Point since the Metering Process (re-)initialization for this Observation Point."})
+	r.Register(InformationElement{Name: "ingressBroadcastPacketTotalCount", Pen: 0x0, ID: 0x164, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of incoming broadcast packets metered at the Observation Point since the Metering Process (re-)initialization for this Observation Point."})
+	r.Register(InformationElement{Name: "egressUnicastPacketTotalCount", Pen: 0x0, ID: 0x165, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of incoming unicast packets metered at the Observation Point since the Metering Process (re-)initialization for this Observation Point."})
+	r.Register(InformationElement{Name: "egressBroadcastPacketTotalCount", Pen: 0x0, ID: 0x166, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "packets", Description: "The total number of incoming broadcast packets metered at the Observation Point since the Metering Process (re-)initialization for this Observation Point."})
+	r.Register(InformationElement{Name: "monitoringIntervalStartMilliSeconds", Pen: 0x0, ID: 0x167, Type: 15, Length: 0x8, Units: "milliseconds", Description: "The absolute timestamp at which the monitoring interval started. A Monitoring interval is the period of time during which the Metering Process is running."})
+	r.Register(InformationElement{Name: "monitoringIntervalEndMilliSeconds", Pen: 0x0, ID: 0x168, Type: 15, Length: 0x8, Units: "milliseconds", Description: "The absolute timestamp at which the monitoring interval ended. A Monitoring interval is the period of time during which the Metering Process is running."})
+	r.Register(InformationElement{Name: "portRangeStart", Pen: 0x0, ID: 0x169, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The port number identifying the start of a range of ports. A value of zero indicates that the range start is not specified, ie the range is defined in some other way.\n\nAdditional information on defined TCP port numbers can be found at [service-names-port-numbers]."})
+	r.Register(InformationElement{Name: "portRangeEnd", Pen: 0x0, ID: 0x16a, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The port number identifying the end of a range of ports. A value of zero indicates that the range end is not specified, ie the range is defined in some other way.\n\nAdditional information on defined TCP port numbers can be found at [service-names-port-numbers]."})
+	r.Register(InformationElement{Name: "portRangeStepSize", Pen: 0x0, ID: 0x16b, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The step size in a port range. The default step size is 1, which indicates contiguous ports. A value of zero indicates that the step size is not specified, ie the range is defined in some other way."})
+	r.Register(InformationElement{Name: "portRangeNumPorts", Pen: 0x0, ID: 0x16c, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "The number of ports in a port range. A value of zero indicates that the number of ports is not specified, ie the range is defined in some other way."})
+	r.Register(InformationElement{Name: "staMacAddress", Pen: 0x0, ID: 0x16d, Type: 12, Length: 0x6, Description: "The IEEE 802 MAC address of a wireless station (STA)."})
+	r.Register(InformationElement{Name: "staIPv4Address", Pen: 0x0, ID: 0x16e, Type: 18, Length: 0x4, Description: "The IPv4 address of a wireless station (STA)."})
+	r.Register(InformationElement{Name: "wtpMacAddress", Pen: 0x0, ID: 0x16f, Type: 12, Length: 0x6, Description: "The IEEE 802 MAC address of a wireless access point (WTP)."})
+	r.Register(InformationElement{Name: "ingressInterfaceType", Pen: 0x0, ID: 0x170, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The type of interface where packets of this Flow are being received. The value matches the value of managed object 'ifType' as defined in [ianaiftype-mib]."})
+	r.Register(InformationElement{Name: "egressInterfaceType", Pen: 0x0, ID: 0x171, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The type of interface where packets of this Flow are being sent. The value matches the value of managed object 'ifType' as defined in [ianaiftype-mib]."})
+	r.Register(InformationElement{Name: "rtpSequenceNumber", Pen: 0x0, ID: 0x172, Type: 2, Length: 0x2, Description: "The RTP sequence number per [RFC3550]."})
+	r.Register(InformationElement{Name: "userName", Pen: 0x0, ID: 0x173, Type: 13, Length: 0xffff, Description: "User name associated with the flow."})
+	r.Register(InformationElement{Name: "applicationCategoryName", Pen: 0x0, ID: 0x174, Type: 13, Length: 0xffff, Description: "An attribute that provides a first level categorization for each Application ID."})
+	r.Register(InformationElement{Name: "applicationSubCategoryName", Pen: 0x0, ID: 0x175, Type: 13, Length: 0xffff, Description: "An attribute that provides a second level categorization for each Application ID."})
+	r.Register(InformationElement{Name: "applicationGroupName", Pen: 0x0, ID: 0x176, Type: 13, Length: 0xffff, Description: "An attribute that groups multiple Application IDs that belong to the same networking application."})
+	r.Register(InformationElement{Name: "originalFlowsPresent", Pen: 0x0, ID: 0x177, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "flows", Description: "The non-conservative count of Original Flows contributing to this Aggregated Flow. Non-conservative counts need not sum to the original count on re-aggregation."})
+	r.Register(InformationElement{Name: "originalFlowsInitiated", Pen: 0x0, ID: 0x178, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "flows", Description: "The conservative count of Original Flows whose first packet is represented within this Aggregated Flow. Conservative counts must sum to the original count on re-aggregation."})
+	r.Register(InformationElement{Name: "originalFlowsCompleted", Pen: 0x0, ID: 0x179, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "flows", Description: "The conservative count of Original Flows whose last packet is represented within this Aggregated Flow. Conservative counts must sum to the original count on re-aggregation."})
+	r.Register(InformationElement{Name: "distinctCountOfSourceIPAddress", Pen: 0x0, ID: 0x17a, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Description: "The count of distinct source IP address values for Original Flows contributing to this Aggregated Flow, without regard to IP version. This Information Element is preferred to the IP-version-specific counters, unless it is important to separate the counts by version."})
+	r.Register(InformationElement{Name: "distinctCountOfDestinationIPAddress", Pen: 0x0, ID: 0x17b, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Description: "The count of distinct destination IP address values for Original Flows contributing to this Aggregated Flow, without regard to IP version. This Information Element is preferred to the version-specific counters below, unless it is important to separate the counts by version."})
+	r.Register(InformationElement{Name: "distinctCountOfSourceIPv4Address", Pen: 0x0, ID: 0x17c, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics, Description: "The count of distinct source IPv4 address values for Original Flows contributing to this Aggregated Flow."})
+	r.Register(InformationElement{Name: "distinctCountOfDestinationIPv4Address", Pen: 0x0, ID: 0x17d, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics, Description: "The count of distinct destination IPv4 address values for Original Flows contributing to this Aggregated Flow."})
+	r.Register(InformationElement{Name: "distinctCountOfSourceIPv6Address", Pen: 0x0, ID: 0x17e, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Description: "The count of distinct source IPv6 address values for Original Flows contributing to this Aggregated Flow."})
+	r.Register(InformationElement{Name: "distinctCountOfDestinationIPv6Address", Pen: 0x0, ID: 0x17f, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Description: "The count of distinct destination IPv6 address values for Original Flows contributing to this Aggregated Flow."})
+	r.Register(InformationElement{Name: "valueDistributionMethod", Pen: 0x0, ID: 0x180, Type: 1, Length: 0x1, Description: "A description of the method used to distribute the counters from Contributing Flows into the Aggregated Flow records described by an associated scope, generally a Template. The method is deemed to apply to all the non-key Information Elements in the referenced scope for which value distribution is a valid operation; if the originalFlowsInitiated and/or originalFlowsCompleted Information Elements appear in the Template, they are not subject to this distribution method, as they each infer their own distribution method. This is intended to be a complete set of possible value distribution methods; it is encoded as follows:\n\n+-------+-----------------------------------------------------------+\n| Value | Description                                               |\n+-------+-----------------------------------------------------------+\n| 0     | Unspecified: The counters for an Original Flow are        |\n|       | explicitly not distributed according to any other method  |\n|       | defined for this Information Element; use for arbitrary   |\n|       | distribution, or distribution algorithms not described by |\n|       | any other codepoint.                                      |\n|       | --------------------------------------------------------- |\n|       |                                                           |\n| 1     | Start Interval: The counters for an Original Flow are     |\n|       | added to the counters of the appropriate Aggregated Flow  |\n|       | containing the start time of the Original Flow.  This     |\n|       | should be assumed the default if value distribution       |\n|       | information is not available at a Collecting Process for  |\n|       | an Aggregated Flow.                                       |\n|       | --------------------------------------------------------- |\n|       |                                                           |\n| 2     | End Interval: The counters for an Original Flow are added |\n|       | to the counters of the appropriate Aggregated Flow        |\n|       | containing the end time of the Original Flow.             |\n|       | --------------------------------------------------------- |\n|       |                                                           |\n| 3     | Mid Interval: The counters for an Original Flow are added |\n|       | to the counters of a single appropriate Aggregated Flow   |\n|       | containing some timestamp between start and end time of   |\n|       | the Original Flow.                                        |\n|       | --------------------------------------------------------- |\n|       |                                                           |\n| 4     | Simple Uniform Distribution: Each counter for an Original |\n|       | Flow is divided by the number of time intervals the       |\n|       | Original Flow covers (i.e., of appropriate Aggregated     |\n|       | Flows sharing the same Flow Key), and this number is      |\n|       | added to each corresponding counter in each Aggregated    |\n|       | Flow.                                                     |\n|       | --------------------------------------------------------- |\n|       |                                                           |\n| 5     | Proportional Uniform Distribution: Each counter for an    |\n|       | Original Flow is divided by the number of time units the  |\n|       | Original Flow covers, to derive a mean count rate.  This  |\n|       | mean count rate is then multiplied by the number of time  |\n|       | units in the intersection of the duration of the Original |\n|       | Flow and the time interval of each Aggregated Flow.  This |\n|       | is like simple uniform distribution, but accounts for the |\n|       | fractional portions of a time interval covered by an      |\n|       | Original Flow in the first and last time interval.        |\n|       | --------------------------------------------------------- |\n|       |                                                           |\n| 6     | Simulated Process: Each counter of the Original Flow is   |\n|       | distributed among the intervals of the Aggregated Flows   |\n|       | according to some function the Intermediate Aggregation   |\n|       | Process uses based upon properties of Flows presumed to   |\n|       | be like the Original Flow.  This is essentially an        |\n|       | assertion that the Intermediate Aggregation Process has   |\n|       | no direct packet timing information but is nevertheless   |\n|       | not using one of the other simpler distribution methods.  |\n|       | The Intermediate Aggregation Process specifically makes   |\n|       | no assertion as to the correctness of the simulation.     |\n|       | --------------------------------------------------------- |\n|       |                                                           |\n| 7     | Direct: The Intermediate Aggregation Process has access   |\n|       | to the original packet timings from the packets making up |\n|       | the Original Flow, and uses these to distribute or        |\n|       | recalculate the counters.                                 |\n+-------+-----------------------------------------------------------+"})
+	r.Register(InformationElement{Name: "rfc3550JitterMilliseconds", Pen: 0x0, ID: 0x181, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "milliseconds", Description: "Interarrival jitter as defined in section 6.4.1 of [RFC3550], measured in milliseconds."})
+	r.Register(InformationElement{Name: "rfc3550JitterMicroseconds", Pen: 0x0, ID: 0x182, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "microseconds", Description: "Interarrival jitter as defined in section 6.4.1 of [RFC3550], measured in microseconds."})
+	r.Register(InformationElement{Name: "rfc3550JitterNanoseconds", Pen: 0x0, ID: 0x183, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Units: "nanoseconds", Description: "Interarrival jitter as defined in section 6.4.1 of [RFC3550], measured in nanoseconds."})
+	r.Register(InformationElement{Name: "dot1qDEI", Pen: 0x0, ID: 0x184, Type: 11, Length: 0x1, Description: "The value of the 1-bit Drop Eligible Indicator (DEI) field of the VLAN tag as described in 802.1Q-2011 subclause 9.6. In case of a QinQ frame, it represents the outer tag's DEI field and in case of an IEEE 802.1ad frame it represents the DEI field of the S-TAG. Note: in earlier versions of 802.1Q the same bit field in the incoming packet is occupied by the Canonical Format Indicator (CFI) field, except for S-TAGs."})
+	r.Register(InformationElement{Name: "dot1qCustomerDEI", Pen: 0x0, ID: 0x185, Type: 11, Length: 0x1, Description: "In case of a QinQ frame, it represents the inner tag's Drop Eligible Indicator (DEI) field and in case of an IEEE 802.1ad frame it represents the DEI field of the C-TAG."})
+	r.Register(InformationElement{Name: "flowSelectorAlgorithm", Pen: 0x0, ID: 0x186, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "This Information Element identifies the Intermediate Flow Selection Process technique (e.g., Filtering, Sampling) that is applied by the Intermediate Flow Selection Process. Most of these techniques have parameters. Its configuration parameter(s) MUST be clearly specified. Further Information Elements are needed to fully specify packet selection with these methods and all their parameters. Further method identifiers may be added to the flowSelectorAlgorithm registry. It might be necessary to define new Information Elements to specify their parameters. The flowSelectorAlgorithm registry is maintained by IANA. New assignments for the registry will be administered by IANA, on a First Come First Served basis [RFC8126], subject to Expert Review [RFC8126]. Please note that the purpose of the flow selection techniques described in this document is the improvement of measurement functions as defined in the Scope (Section 1). Before adding new flow selector algorithms it should be checked what is their intended purpose and especially if those contradict with policies defined in [RFC2804]. The designated expert(s) should consult with the community if a request is received that runs counter to [RFC2804]. The registry can be updated when specifications of the new method(s) and any new Information Elements are provided. The group of experts must double check the flowSelectorAlgorithm definitions and Information Elements with already defined flowSelectorAlgorithm and Information Elements for completeness, accuracy, and redundancy. Those experts will initially be drawn from the Working Group Chairs and document editors of the IPFIX and PSAMP Working Groups. The Intermediate Flow Selection Process Techniques identifiers are defined at [http://www.iana.org/assignments/ipfix/ipfix.xml#ipfix-flowselectoralgorithm]."})
+	r.Register(InformationElement{Name: "flowSelectedOctetDeltaCount", Pen: 0x0, ID: 0x187, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "This Information Element specifies the volume in octets of all Flows that are selected in the Intermediate Flow Selection Process since the previous report."})
+	r.Register(InformationElement{Name: "flowSelectedPacketDeltaCount", Pen: 0x0, ID: 0x188, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "packets", Description: "This Information Element specifies the volume in packets of all Flows that were selected in the Intermediate Flow Selection Process since the previous report."})
+	r.Register(InformationElement{Name: "flowSelectedFlowDeltaCount", Pen: 0x0, ID: 0x189, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "flows", Description: "This Information Element specifies the number of Flows that were selected in the Intermediate Flow Selection Process since the last report."})
+	r.Register(InformationElement{Name: "selectorIDTotalFlowsObserved", Pen: 0x0, ID: 0x18a, Type: 4, Length: 0x8, Units: "flows", Description: "This Information Element specifies the total number of Flows observed by a Selector, for a specific value of SelectorId. This Information Element should be used in an Options Template scoped to the observation to which it refers. See Section 3.4.2.1 of the IPFIX protocol document [RFC7011]."})
+	r.Register(InformationElement{Name: "selectorIDTotalFlowsSelected", Pen: 0x0, ID: 0x18b, Type: 4, Length: 0x8, Units: "flows", Description: "This Information Element specifies the total number of Flows selected by a Selector, for a specific value of SelectorId. This Information Element should be used in an Options Template scoped to the observation to which it refers. See Section 3.4.2.1 of the IPFIX protocol document [RFC7011]."})
+	r.Register(InformationElement{Name: "samplingFlowInterval", Pen: 0x0, ID: 0x18c, Type: 4, Length: 0x8, Units: "flows", Description: "This Information Element specifies the number of Flows that are consecutively sampled. A value of 100 means that 100 consecutive Flows are sampled. For example, this Information Element may be used to describe the configuration of a systematic count-based Sampling Selector."})
+	r.Register(InformationElement{Name: "samplingFlowSpacing", Pen: 0x0, ID: 0x18d, Type: 4, Length: 0x8, Units: "flows", Description: "This Information Element specifies the number of Flows between two \"samplingFlowInterval\"s. A value of 100 means that the next interval starts 100 Flows (which are not sampled) after the current \"samplingFlowInterval\" is over. For example, this Information Element may be used to describe the configuration of a systematic count-based Sampling Selector."})
+	r.Register(InformationElement{Name: "flowSamplingTimeInterval", Pen: 0x0, ID: 0x18e, Type: 4, Length: 0x8, Units: "microseconds", Description: "This Information Element specifies the time interval in microseconds during which all arriving Flows are sampled. For example, this Information Element may be used to describe the configuration of a systematic time-based Sampling Selector."})
+	r.Register(InformationElement{Name: "flowSamplingTimeSpacing", Pen: 0x0, ID: 0x18f, Type: 4, Length: 0x8, Units: "microseconds", Description: "This Information Element specifies the time interval in microseconds between two \"flowSamplingTimeInterval\"s. A value of 100 means that the next interval starts 100 microseconds (during which no Flows are sampled) after the current \"flowsamplingTimeInterval\" is over. For example, this Information Element may used to describe the configuration of a systematic time-based Sampling Selector."})
+	r.Register(InformationElement{Name: "hashFlowDomain", Pen: 0x0, ID: 0x190, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Description: "This Information Element specifies the Information Elements that are used by the Hash-based Flow Selector as the Hash Domain."})
+	r.Register(InformationElement{Name: "transportOctetDeltaCount", Pen: 0x0, ID: 0x191, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The number of octets, excluding IP header(s) and Layer 4 transport protocol header(s), observed for this Flow at the Observation Point since the previous report (if any)."})
+	r.Register(InformationElement{Name: "transportPacketDeltaCount", Pen: 0x0, ID: 0x192, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "packets", Description: "The number of packets containing at least one octet beyond the IP header(s) and Layer 4 transport protocol header(s), observed for this Flow at the Observation Point since the previous report (if any)."})
+	r.Register(InformationElement{Name: "originalExporterIPv4Address", Pen: 0x0, ID: 0x193, Type: 18, Length: 0x4, Description: "The IPv4 address used by the Exporting Process on an Original Exporter, as seen by the Collecting Process on an IPFIX Mediator. Used to provide information about the Original Observation Points to a downstream Collector."})
+	r.Register(InformationElement{Name: "originalExporterIPv6Address", Pen: 0x0, ID: 0x194, Type: 19, Length: 0x10, Description: "The IPv6 address used by the Exporting Process on an Original Exporter, as seen by the Collecting Process on an IPFIX Mediator. Used to provide information about the Original Observation Points to a downstream Collector."})
+	r.Register(InformationElement{Name: "originalObservationDomainId", Pen: 0x0, ID: 0x195, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "The Observation Domain ID reported by the Exporting Process on an Original Exporter, as seen by the Collecting Process on an IPFIX Mediator. Used to provide information about the Original Observation Domain to a downstream Collector. When cascading through multiple Mediators, this identifies the initial Observation Domain in the cascade."})
+	r.Register(InformationElement{Name: "intermediateProcessId", Pen: 0x0, ID: 0x196, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "Description: An identifier of an Intermediate Process that is unique per IPFIX Device. Typically, this Information Element is used for limiting the scope of other Information Elements. Note that process identifiers may be assigned dynamically; that is, an Intermediate Process may be restarted with a different ID."})
+	r.Register(InformationElement{Name: "ignoredDataRecordTotalCount", Pen: 0x0, ID: 0x197, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Description: "Description: The total number of received Data Records that the Intermediate Process did not process since the (re-)initialization of the Intermediate Process; includes only Data Records not examined or otherwise handled by the Intermediate Process due to resource constraints, not Data Records that were examined or otherwise handled by the Intermediate Process but those that merely do not contribute to any exported Data Record due to the operations performed by the Intermediate Process."})
+	r.Register(InformationElement{Name: "dataLinkFrameType", Pen: 0x0, ID: 0x198, Type: 2, Length: 0x2, Semantics: FlagsSemantics, Description: "This Information Element specifies the type of the selected data link frame.\n\nThe following data link types are defined here:\n\n- 0x01 IEEE802.3 ETHERNET [IEEE802.3]\n\n- 0x02 IEEE802.11 MAC Frame format [IEEE802.11]\n\nFurther values may be assigned by IANA. Note that the assigned values are bits so that multiple observations can be OR'd together.\n\nThe data link layer is defined in [ISO/IEC.7498-1:1994]."})
+	r.Register(InformationElement{Name: "sectionOffset", Pen: 0x0, ID: 0x199, Type: 2, Length: 0x2, Semantics: QuantitySemantics, Description: "This Information Element specifies the offset of the packet section (e.g., dataLinkFrameSection, ipHeaderPacketSection, ipPayloadPacketSection, mplsLabelStackSection, and mplsPayloadPacketSection). If this Information Element is omitted, it defaults to zero (i.e., no offset).\n\nIf multiple sectionOffset Information Elements are specified within a single Template, then they apply to the packet section Information Elements in order: the first sectionOffset applies to the first packet section, the second to the second, and so on. Note that the \"closest\" sectionOffset and packet section Information Elements within a given Template are not necessarily related. If there are fewer sectionOffset Information Elements than packet section Information Elements, then subsequent packet section Information Elements have no offset, i.e., a sectionOffset of zero applies to those packet section Information Elements. If there are more sectionOffset Information Elements than the number of packet section Information Elements, then the additional sectionOffset Information Elements are meaningless."})
+	r.Register(InformationElement{Name: "sectionExportedOctets", Pen: 0x0, ID: 0x19a, Type: 2, Length: 0x2, Semantics: QuantitySemantics, Description: "This Information Element specifies the observed length of the packet section (e.g., dataLinkFrameSection, ipHeaderPacketSection, ipPayloadPacketSection, mplsLabelStackSection, and mplsPayloadPacketSection) when padding is used.\n\nThe packet section may be of a fixed size larger than the sectionExportedOctets. In this case, octets in the packet section beyond the sectionExportedOctets MUST follow the [RFC7011] rules for padding (i.e., be composed of zero (0) valued octets)."})
+	r.Register(InformationElement{Name: "dot1qServiceInstanceTag", Pen: 0x0, ID: 0x19b, Type: 0, Length: 0xffff, Description: "This Information Element, which is 16 octets long, represents the Backbone Service Instance Tag (I-TAG) Tag Control Information (TCI) field of an Ethernet frame as described in [IEEE802.1Q]. It encodes the Backbone Service Instance Priority Code Point (I-PCP), Backbone Service Instance Drop Eligible Indicator (I-DEI), Use Customer Addresses (UCAs), Backbone Service Instance Identifier (I-SID), Encapsulated Customer Destination Address (C-DA), Encapsulated Customer Source Address (C-SA), and reserved fields. The structure and semantics within the Tag Control Information field are defined in [IEEE802.1Q]."})
+	r.Register(InformationElement{Name: "dot1qServiceInstanceId", Pen: 0x0, ID: 0x19c, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Range: "0-0xFFFFFF", Description: "The value of the 24-bit Backbone Service Instance Identifier (I-SID) portion of the Backbone Service Instance Tag (I-TAG) Tag Control Information (TCI) field of an Ethernet frame as described in [IEEE802.1Q]."})
+	r.Register(InformationElement{Name: "dot1qServiceInstancePriority", Pen: 0x0, ID: 0x19d, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Range: "0-7", Description: "The value of the 3-bit Backbone Service Instance Priority Code Point (I-PCP) portion of the Backbone Service Instance Tag (I-TAG) Tag Control Information (TCI) field of an Ethernet frame as described in [IEEE802.1Q]."})
+	r.Register(InformationElement{Name: "dot1qCustomerSourceMacAddress", Pen: 0x0, ID: 0x19e, Type: 12, Length: 0x6, Description: "The value of the Encapsulated Customer Source Address (C-SA) portion of the Backbone Service Instance Tag (I-TAG) Tag Control Information (TCI) field of an Ethernet frame as described in [IEEE802.1Q]."})
+	r.Register(InformationElement{Name: "dot1qCustomerDestinationMacAddress", Pen: 0x0, ID: 0x19f, Type: 12, Length: 0x6, Description: "The value of the Encapsulated Customer Destination Address (C-DA) portion of the Backbone Service Instance Tag (I-TAG) Tag Control Information (TCI) field of an Ethernet frame as described in [IEEE802.1Q]."})
+	r.Register(InformationElement{Name: "postLayer2OctetDeltaCount", Pen: 0x0, ID: 0x1a1, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The definition of this Information Element is identical to the definition of the layer2OctetDeltaCount Information Element, except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point.\n\nThis Information Element is the layer 2 version of postOctetDeltaCount (ElementId #23)."})
+	r.Register(InformationElement{Name: "postMCastLayer2OctetDeltaCount", Pen: 0x0, ID: 0x1a2, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The number of layer 2 octets since the previous report (if any) in outgoing multicast packets sent for packets of this Flow by a multicast daemon within the Observation Domain. This property cannot necessarily be observed at the Observation Point but may be retrieved by other means. The number of octets includes layer 2 header(s) and layer 2 payload.\n\nThis Information Element is the layer 2 version of postMCastOctetDeltaCount (ElementId #20)."})
+	r.Register(InformationElement{Name: "postLayer2OctetTotalCount", Pen: 0x0, ID: 0x1a4, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The definition of this Information Element is identical to the definition of the layer2OctetTotalCount Information Element, except that it reports a potentially modified value caused by a middlebox function after the packet passed the Observation Point.\n\nThis Information Element is the layer 2 version of postOctetTotalCount (ElementId #171)."})
+	r.Register(InformationElement{Name: "postMCastLayer2OctetTotalCount", Pen: 0x0, ID: 0x1a5, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of layer 2 octets in outgoing multicast packets sent for packets of this Flow by a multicast daemon in the Observation Domain since the Metering Process (re-)initialization. This property cannot necessarily be observed at the Observation Point but may be retrieved by other means. The number of octets includes layer 2 header(s) and layer 2 payload.\n\nThis Information Element is the layer 2 version of postMCastOctetTotalCount (ElementId #175)."})
+	r.Register(InformationElement{Name: "minimumLayer2TotalLength", Pen: 0x0, ID: 0x1a6, Type: 4, Length: 0x8, Units: "octets", Description: "Layer 2 length of the smallest packet observed for this Flow. The packet length includes the length of the layer 2 header(s) and the length of the layer 2 payload.\n\nThis Information Element is the layer 2 version of minimumIpTotalLength (ElementId #25)."})
+	r.Register(InformationElement{Name: "maximumLayer2TotalLength", Pen: 0x0, ID: 0x1a7, Type: 4, Length: 0x8, Units: "octets", Description: "Layer 2 length of the largest packet observed for this Flow. The packet length includes the length of the layer 2 header(s) and the length of the layer 2 payload.\n\nThis Information Element is the layer 2 version of maximumIpTotalLength (ElementId #26)."})
+	r.Register(InformationElement{Name: "droppedLayer2OctetDeltaCount", Pen: 0x0, ID: 0x1a8, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The number of layer 2 octets since the previous report (if any) in packets of this Flow dropped by packet treatment. The number of octets includes layer 2 header(s) and layer 2 payload.\n\nThis Information Element is the layer 2 version of droppedOctetDeltaCount (ElementId #132)."})
+	r.Register(InformationElement{Name: "droppedLayer2OctetTotalCount", Pen: 0x0, ID: 0x1a9, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of octets in observed layer 2 packets (including the layer 2 header) that were dropped by packet treatment since the (re-)initialization of the Metering Process.\n\nThis Information Element is the layer 2 version of droppedOctetTotalCount (ElementId #134)."})
+	r.Register(InformationElement{Name: "ignoredLayer2OctetTotalCount", Pen: 0x0, ID: 0x1aa, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of octets in observed layer 2 packets (including the layer 2 header) that the Metering Process did not process since the (re-)initialization of the Metering Process.\n\nThis Information Element is the layer 2 version of ignoredOctetTotalCount (ElementId #165)."})
+	r.Register(InformationElement{Name: "notSentLayer2OctetTotalCount", Pen: 0x0, ID: 0x1ab, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total number of octets in observed layer 2 packets (including the layer 2 header) that the Metering Process did not process since the (re-)initialization of the Metering Process.\n\nThis Information Element is the layer 2 version of notSentOctetTotalCount (ElementId #168)."})
+	r.Register(InformationElement{Name: "layer2OctetDeltaSumOfSquares", Pen: 0x0, ID: 0x1ac, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "octets", Description: "The sum of the squared numbers of layer 2 octets per incoming packet since the previous report (if any) for this Flow at the Observation Point. The number of octets includes layer 2 header(s) and layer 2 payload.\n\nThis Information Element is the layer 2 version of octetDeltaSumOfSquares (ElementId #198)."})
+	r.Register(InformationElement{Name: "layer2OctetTotalSumOfSquares", Pen: 0x0, ID: 0x1ad, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets", Description: "The total sum of the squared numbers of layer 2 octets in incoming packets for this Flow at the Observation Point since the Metering Process (re-)initialization for this Observation Point. The number of octets includes layer 2 header(s) and layer 2 payload.\n\nThis Information Element is the layer 2 version of octetTotalSumOfSquares (ElementId #199)."})
+	r.Register(InformationElement{Name: "layer2FrameDeltaCount", Pen: 0x0, ID: 0x1ae, Type: 4, Length: 0x8, Semantics: DeltaCounterSemantics, Units: "frames", Description: "The number of incoming layer 2 frames since the previous report (if any) for this Flow at the Observation Point."})
+	r.Register(InformationElement{Name: "layer2FrameTotalCount", Pen: 0x0, ID: 0x1af, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "frames", Description: "The total number of incoming layer 2 frames for this Flow at the Observation Point since the Metering Process (re-)initialization for this Observation Point."})
+	r.Register(InformationElement{Name: "pseudoWireDestinationIPv4Address", Pen: 0x0, ID: 0x1b0, Type: 18, Length: 0x4, Description: "The destination IPv4 address of the PSN tunnel carrying the pseudowire."})
+	r.Register(InformationElement{Name: "ignoredLayer2FrameTotalCount", Pen: 0x0, ID: 0x1b1, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "frames", Description: "The total number of observed layer 2 frames that the Metering Process did not process since the (re-)initialization of the Metering Process. This Information Element is the layer 2 version of ignoredPacketTotalCount (ElementId #164)."})
+	r.Register(InformationElement{Name: "mibObjectValueInteger", Pen: 0x0, ID: 0x1b2, Type: 7, Length: 0x4, Semantics: QuantitySemantics, Description: "An IPFIX Information Element that denotes that the integer value of a MIB object will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with the Base syntax of Integer32 and INTEGER with IPFIX reduced-size encoding used as required. The value is encoded as per the standard IPFIX Abstract Data Type of signed32."})
+	r.Register(InformationElement{Name: "mibObjectValueOctetString", Pen: 0x0, ID: 0x1b3, Type: 0, Length: 0xffff, Description: "An IPFIX Information Element that denotes that an Octet String or Opaque value of a MIB object will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with the Base syntax of OCTET STRING and Opaque. The value is encoded as per the standard IPFIX Abstract Data Type of octetArray."})
+	r.Register(InformationElement{Name: "mibObjectValueOID", Pen: 0x0, ID: 0x1b4, Type: 0, Length: 0xffff, Description: "An IPFIX Information Element that denotes that an Object Identifier or OID value of a MIB object will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with the Base syntax of OBJECT IDENTIFIER. Note: In this case, the \"mibObjectIdentifier\" defines which MIB object is being exported, and the \"mibObjectValueOID\" field will contain the OID value of that MIB object. The mibObjectValueOID Information Element is encoded as ASN.1/BER [X.690] in an octetArray."})
+	r.Register(InformationElement{Name: "mibObjectValueBits", Pen: 0x0, ID: 0x1b5, Type: 0, Length: 0xffff, Semantics: FlagsSemantics, Description: "An IPFIX Information Element that denotes that a set of Enumerated flags or bits from a MIB object will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with the Base syntax of BITS. The flags or bits are encoded as per the standard IPFIX Abstract Data Type of octetArray, with sufficient length to accommodate the required number of bits. If the number of bits is not an integer multiple of octets, then the most significant bits at the end of the octetArray MUST be set to 0."})
+	r.Register(InformationElement{Name: "mibObjectValueIPAddress", Pen: 0x0, ID: 0x1b6, Type: 18, Length: 0x4, Description: "An IPFIX Information Element that denotes that the IPv4 address value of a MIB object will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with the Base syntax of IpAddress. The value is encoded as per the standard IPFIX Abstract Data Type of ipv4Address."})
+	r.Register(InformationElement{Name: "mibObjectValueCounter", Pen: 0x0, ID: 0x1b7, Type: 4, Length: 0x8, Semantics: SnmpCounterSemantics, Description: "An IPFIX Information Element that denotes that the counter value of a MIB object will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with the Base syntax of Counter32 or Counter64 with IPFIX reduced-size encoding used as required. The value is encoded as per the standard IPFIX Abstract Data Type of unsigned64."})
+	r.Register(InformationElement{Name: "mibObjectValueGauge", Pen: 0x0, ID: 0x1b8, Type: 3, Length: 0x4, Semantics: SnmpGaugeSemantics, Description: "An IPFIX Information Element that denotes that the Gauge value of a MIB object will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with the Base syntax of Gauge32. The value is encoded as per the standard IPFIX Abstract Data Type of unsigned32. This value represents a non-negative integer that may increase or decrease but that shall never exceed a maximum value or fall below a minimum value."})
+	r.Register(InformationElement{Name: "mibObjectValueTimeTicks", Pen: 0x0, ID: 0x1b9, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Description: "An IPFIX Information Element that denotes that the TimeTicks value of a MIB object will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with the Base syntax of TimeTicks. The value is encoded as per the standard IPFIX Abstract Data Type of unsigned32."})
+	r.Register(InformationElement{Name: "mibObjectValueUnsigned", Pen: 0x0, ID: 0x1ba, Type: 3, Length: 0x4, Semantics: QuantitySemantics, Description: "An IPFIX Information Element that denotes that an unsigned integer value of a MIB object will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with the Base syntax of unsigned32 with IPFIX reduced-size encoding used as required. The value is encoded as per the standard IPFIX Abstract Data Type of unsigned32."})
+	r.Register(InformationElement{Name: "mibObjectValueTable", Pen: 0x0, ID: 0x1bb, Type: 21, Length: 0xffff, Semantics: ListSemantics, Description: "An IPFIX Information Element that denotes that a complete or partial conceptual table will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with a syntax of SEQUENCE OF. This is encoded as a subTemplateList of mibObjectValue Information Elements. The Template specified in the subTemplateList MUST be an Options Template and MUST include all the objects listed in the INDEX clause as Scope Fields."})
+	r.Register(InformationElement{Name: "mibObjectValueRow", Pen: 0x0, ID: 0x1bc, Type: 21, Length: 0xffff, Semantics: ListSemantics, Description: "An IPFIX Information Element that denotes that a single row of a conceptual table will be exported. The MIB Object Identifier (\"mibObjectIdentifier\") for this field MUST be exported in a MIB Field Option or via another means. This Information Element is used for MIB objects with a syntax of SEQUENCE. This is encoded as a subTemplateList of mibObjectValue Information Elements. The subTemplateList exported MUST contain exactly one row (i.e., one instance of the subTemplate). The Template specified in the subTemplateList MUST be an Options Template and MUST include all the objects listed in the INDEX clause as Scope Fields."})
+	r.Register(InformationElement{Name: "mibObjectIdentifier", Pen: 0x0, ID: 0x1bd, Type: 0, Length: 0xffff, Description: "An IPFIX Information Element that denotes that a MIB Object Identifier (MIB OID) is exported in the (Options) Template Record. The mibObjectIdentifier Information Element contains the OID assigned to the MIB object type definition encoded as ASN.1/BER [X.690]."})
+	r.Register(InformationElement{Name: "mibSubIdentifier", Pen: 0x0, ID: 0x1be, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "A non-negative sub-identifier of an Object Identifier (OID)."})
+	r.Register(InformationElement{Name: "mibIndexIndicator", Pen: 0x0, ID: 0x1bf, Type: 4, Length: 0x8, Semantics: FlagsSemantics, Description: "A set of bit fields that is used for marking the Information Elements of a Data Record that serve as INDEX MIB objects for an indexed columnar MIB object. Each bit represents an Information Element in the Data Record, with the n-th least significant bit representing the n-th Information Element. A bit set to 1 indicates that the corresponding Information Element is an index of the columnar object represented by the mibObjectValue. A bit set to 0 indicates that this is not the case.\n\nIf the Data Record contains more than 64 Information Elements, the corresponding Template SHOULD be designed such that all index fields are among the first 64 Information Elements, because the mibIndexIndicator only contains 64 bits. If the Data Record contains less than 64 Information Elements, then the extra bits in the mibIndexIndicator for which no corresponding Information Element exists MUST have the value 0 and must be disregarded by the Collector. This Information Element may be exported with IPFIX reduced-size encoding."})
+	r.Register(InformationElement{Name: "mibCaptureTimeSemantics", Pen: 0x0, ID: 0x1c0, Type: 1, Length: 0x1, Semantics: IdentifierSemantics, Description: "Indicates when in the lifetime of the Flow the MIB value was retrieved from the MIB for a mibObjectIdentifier. This is used to indicate if the value exported was collected from the MIB closer to Flow creation or Flow export time and refers to the Timestamp fields included in the same Data Record. This field SHOULD be used when exporting a mibObjectValue that specifies counters or statistics.\n\nIf the MIB value was sampled by SNMP prior to the IPFIX Metering Process or Exporting Process retrieving the value (i.e., the data is already stale) and it is important to know the exact sampling time, then an additional observationTime* element should be paired with the OID using IPFIX Structured Data [RFC6313]. Similarly, if different MIB capture times apply to different mibObjectValue elements within the Data Record, then individual mibCaptureTimeSemantics Information Elements should be paired with each OID using IPFIX Structured Data.\n\nValues:\n\n0 undefined\n\n1 begin - The value for the MIB object is captured from the MIB when the Flow is first observed\n\n2 end - The value for the MIB object is captured from the MIB when the Flow ends\n\n3 export - The value for the MIB object is captured from the MIB at export time\n\n4 average - The value for the MIB object is an average of multiple captures from the MIB over the observed life of the Flow"})
+	r.Register(InformationElement{Name: "mibContextEngineID", Pen: 0x0, ID: 0x1c1, Type: 0, Length: 0xffff, Description: "A mibContextEngineID that specifies the SNMP engine ID for a MIB field being exported over IPFIX. Definition as per [RFC3411], Section 3.3."})
+	r.Register(InformationElement{Name: "mibContextName", Pen: 0x0, ID: 0x1c2, Type: 13, Length: 0xffff, Description: "An Information Element that denotes that a MIB context name is specified for a MIB field being exported over IPFIX. Reference [RFC3411], Section 3.3."})
+	r.Register(InformationElement{Name: "mibObjectName", Pen: 0x0, ID: 0x1c3, Type: 13, Length: 0xffff, Description: "The name (called a descriptor in [RFC2578] of an object type definition."})
+	r.Register(InformationElement{Name: "mibObjectDescription", Pen: 0x0, ID: 0x1c4, Type: 13, Length: 0xffff, Description: "The value of the DESCRIPTION clause of a MIB object type definition."})
+	r.Register(InformationElement{Name: "mibObjectSyntax", Pen: 0x0, ID: 0x1c5, Type: 13, Length: 0xffff, Description: "The value of the SYNTAX clause of a MIB object type definition, which may include a textual convention or sub-typing. See [RFC2578]."})
+	r.Register(InformationElement{Name: "mibModuleName", Pen: 0x0, ID: 0x1c6, Type: 13, Length: 0xffff, Description: "The textual name of the MIB module that defines a MIB object."})
+	r.Register(InformationElement{Name: "mobileIMSI", Pen: 0x0, ID: 0x1c7, Type: 13, Length: 0xffff, Description: "The International Mobile Subscription Identity (IMSI). The IMSI is a decimal digit string with up to a maximum of 15 ASCII/UTF-8 encoded digits (0x30 - 0x39)."})
+	r.Register(InformationElement{Name: "mobileMSISDN", Pen: 0x0, ID: 0x1c8, Type: 13, Length: 0xffff, Description: "The Mobile Station International Subscriber Directory Number (MSISDN). The MSISDN is a decimal digit string with up to a maximum of 15 ASCII/UTF-8 encoded digits (0x30 - 0x39)."})
+	r.Register(InformationElement{Name: "httpStatusCode", Pen: 0x0, ID: 0x1c9, Type: 2, Length: 0x2, Semantics: IdentifierSemantics, Range: "0-999", Description: "The HTTP Response Status Code, as defined in section 6 of [RFC7231], associated with a flow. Implies that the flow record represents a flow containing an HTTP Response."})
+	r.Register(InformationElement{Name: "sourceTransportPortsLimit", Pen: 0x0, ID: 0x1ca, Type: 2, Length: 0x2, Semantics: QuantitySemantics, Units: "ports", Range: "1-65535", Description: "This Information Element contains the maximum number of IP source transport ports that can be used by an end user when sending IP packets; each user is associated with one or more (source) IPv4 or IPv6 addresses. This Information Element is particularly useful in address-sharing deployments that adhere to REQ-4 of [RFC6888]. Limiting the number of ports assigned to each user ensures fairness among users and mitigates the denial-of-service attack that a user could launch against other users through the address-sharing device in order to grab more ports."})
+	r.Register(InformationElement{Name: "httpRequestMethod", Pen: 0x0, ID: 0x1cb, Type: 13, Length: 0xffff, Description: "The HTTP request method, as defined in section 4 of [RFC7231], associated with a flow. String with up to 8 UTF-8 characters."})
+	r.Register(InformationElement{Name: "httpRequestHost", Pen: 0x0, ID: 0x1cc, Type: 13, Length: 0xffff, Description: "The HTTP request host, as defined in section 5.4 of [RFC7230] or, in the case of HTTP/2, the content of the :authority pseudo-header field as defined in section 8.1.2.3 of [RFC7240]. Encoded in UTF-8."})
+	r.Register(InformationElement{Name: "httpRequestTarget", Pen: 0x0, ID: 0x1cd, Type: 13, Length: 0xffff, Description: "The HTTP request target, as defined in section 2 of [RFC7231] and in section 5.3 of [RFC7230], associated with a flow. Or the HTTP/2 \":path\" pseudo-header field as defined in section 8.1.2.3 of [RFC7240]. Encoded in UTF-8."})
+	r.Register(InformationElement{Name: "httpMessageVersion", Pen: 0x0, ID: 0x1ce, Type: 13, Length: 0xffff, Description: "The version of an HTTP/1.1 message as indicated by the HTTP-version field, defined in section 2.6 of [RFC7230], or the version identification of an HTTP/2 frame as defined in [RFC7240] section 3.1. The length of this field is limited to 10 characters, UTF-8 encoded."})
+	r.Register(InformationElement{Name: "natInstanceID", Pen: 0x0, ID: 0x1cf, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This Information Element uniquely identifies an Instance of the NAT that runs on a NAT middlebox function after the packet passes the Observation Point. natInstanceID is defined in [RFC7659]."})
+	r.Register(InformationElement{Name: "internalAddressRealm", Pen: 0x0, ID: 0x1d0, Type: 0, Length: 0xffff, Semantics: IdentifierSemantics, Description: "This Information Element represents the internal address realm where the packet is originated from or destined to. By definition, a NAT mapping can be created from two address realms, one from internal and one from external. Realms are implementation dependent and can represent a Virtual Routing and Forwarding (VRF) ID, a VLAN ID, or some unique identifier. Realms are optional and, when left unspecified, would mean that the external and internal realms are the same."})
+	r.Register(InformationElement{Name: "externalAddressRealm", Pen: 0x0, ID: 0x1d1, Type: 0, Length: 0xffff, Semantics: IdentifierSemantics, Description: "This Information Element represents the external address realm where the packet is originated from or destined to. The detailed definition is in the internal address realm as specified above."})
+	r.Register(InformationElement{Name: "natQuotaExceededEvent", Pen: 0x0, ID: 0x1d2, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This Information Element identifies the type of a NAT Quota Exceeded event. Values for this Information Element are listed in the \"NAT Quota Exceeded Event Type\" registry, see [http://www.iana.org/assignments/ipfix/ipfix.xhtml#ipfix-nat-quota-exceeded-event]. New assignments of values will be administered by IANA and are subject to Expert Review [RFC8126]. Experts need to check definitions of new values for completeness, accuracy, and redundancy."})
+	r.Register(InformationElement{Name: "natThresholdEvent", Pen: 0x0, ID: 0x1d3, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This Information Element identifies a type of a NAT Threshold event. Values for this Information Element are listed in the \"NAT Threshold Event Type\" registry, see [http://www.iana.org/assignments/ipfix/ipfix.xhtml#ipfix-nat-threshold-event]. New assignments of values will be administered by IANA and are subject to Expert Review [RFC8126]. Experts need to check definitions of new values for completeness, accuracy, and redundancy."})
+	r.Register(InformationElement{Name: "httpUserAgent", Pen: 0x0, ID: 0x1d4, Type: 13, Length: 0xffff, Description: "The HTTP User-Agent header field as defined in section 5.5.3 of [RFC7231]. Encoded in UTF-8."})
+	r.Register(InformationElement{Name: "httpContentType", Pen: 0x0, ID: 0x1d5, Type: 13, Length: 0xffff, Description: "The HTTP Content-Type header field as defined in section 3.1.1.5 of [RFC7231]. Encoded in UTF-8."})
+	r.Register(InformationElement{Name: "httpReasonPhrase", Pen: 0x0, ID: 0x1d6, Type: 13, Length: 0xffff, Description: "The HTTP reason phrase as defined in section 6.1 of of [RFC7231]."})
+	r.Register(InformationElement{Name: "maxSessionEntries", Pen: 0x0, ID: 0x1d7, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the maximum session entries that can be created by the NAT device."})
+	r.Register(InformationElement{Name: "maxBIBEntries", Pen: 0x0, ID: 0x1d8, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the maximum BIB entries that can be created by the NAT device."})
+	r.Register(InformationElement{Name: "maxEntriesPerUser", Pen: 0x0, ID: 0x1d9, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the maximum NAT entries that can be created per user by the NAT device."})
+	r.Register(InformationElement{Name: "maxSubscribers", Pen: 0x0, ID: 0x1da, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the maximum subscribers or maximum hosts that are allowed by the NAT device."})
+	r.Register(InformationElement{Name: "maxFragmentsPendingReassembly", Pen: 0x0, ID: 0x1db, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the maximum fragments that the NAT device can store for reassembling the packet."})
+	r.Register(InformationElement{Name: "addressPoolHighThreshold", Pen: 0x0, ID: 0x1dc, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the high threshold value of the number of public IP addresses in the address pool."})
+	r.Register(InformationElement{Name: "addressPoolLowThreshold", Pen: 0x0, ID: 0x1dd, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the low threshold value of the number of public IP addresses in the address pool."})
+	r.Register(InformationElement{Name: "addressPortMappingHighThreshold", Pen: 0x0, ID: 0x1de, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the high threshold value of the number of address and port mappings."})
+	r.Register(InformationElement{Name: "addressPortMappingLowThreshold", Pen: 0x0, ID: 0x1df, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the low threshold value of the number of address and port mappings."})
+	r.Register(InformationElement{Name: "addressPortMappingPerUserHighThreshold", Pen: 0x0, ID: 0x1e0, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the high threshold value of the number of address and port mappings that a single user is allowed to create on a NAT device."})
+	r.Register(InformationElement{Name: "globalAddressMappingHighThreshold", Pen: 0x0, ID: 0x1e1, Type: 3, Length: 0x4, Semantics: IdentifierSemantics, Description: "This element represents the high threshold value of the number of address and port mappings that a single user is allowed to create on a NAT device in a paired address pooling behavior."})
+	r.Register(InformationElement{Name: "vpnIdentifier", Pen: 0x0, ID: 0x1e2, Type: 0, Length: 0xffff, Description: "VPN ID in the format specified by [RFC2685]. The size of this Information Element is 7 octets."})
 }
@@ -0,0 +1,35 @@
+package ipfix
+
+// GENERATED BY generate_spec; DO NOT CHANGE!
+
+import "sync"
+
+var onceLoadNtopSpec sync.Once
+
+// LoadNtopSpec loads information elements from the given specification into the DefaultRegistry.
+// It is safe to call LoadNtopSpec several times and concurrently.
+func LoadNtopSpec() {
+	onceLoadNtopSpec.Do(func() {
+		LoadNtopSpecInto(DefaultRegistry)
+	})
+}
+
+// LoadNtopSpecInto registers the information elements from the given specification in r
+func LoadNtopSpecInto(r *Registry) {
+	r.Register(InformationElement{Name: "L7_PROTO", Pen: 0x8b30, ID: 0x76, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "L7_PROTO_NAME", Pen: 0x8b30, ID: 0x77, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "CLIENT_NW_LATENCY_MS", Pen: 0x8b30, ID: 0x7b, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "SERVER_NW_LATENCY_MS", Pen: 0x8b30, ID: 0x7c, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "APPL_LATENCY_MS", Pen: 0x8b30, ID: 0x7d, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "HTTP_URL", Pen: 0x8b30, ID: 0xb4, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "HTTP_RET_CODE", Pen: 0x8b30, ID: 0xb5, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "HTTP_REFERER", Pen: 0x8b30, ID: 0xb6, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "HTTP_UA", Pen: 0x8b30, ID: 0xb7, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "HTTP_MIME", Pen: 0x8b30, ID: 0xb8, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "HTTP_HOST", Pen: 0x8b30, ID: 0xbb, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "DNS_QUERY", Pen: 0x8b30, ID: 0xcd, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "DNS_QUERY_ID", Pen: 0x8b30, ID: 0xce, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "DNS_QUERY_TYPE", Pen: 0x8b30, ID: 0xcf, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "DNS_RET_CODE", Pen: 0x8b30, ID: 0xd0, Type: 1, Length: 0x1, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "DNS_NUM_ANSWERS", Pen: 0x8b30, ID: 0xd1, Type: 1, Length: 0x1, Semantics: TotalCounterSemantics})
+}
@@ -0,0 +1,29 @@
+package ipfix
+
+// GENERATED BY generate_spec; DO NOT CHANGE!
+
+import "sync"
+
+var onceLoadVMwareSpec sync.Once
+
+// LoadVMwareSpec loads information elements from the given specification into the DefaultRegistry.
+// It is safe to call LoadVMwareSpec several times and concurrently.
+func LoadVMwareSpec() {
+	onceLoadVMwareSpec.Do(func() {
+		LoadVMwareSpecInto(DefaultRegistry)
+	})
+}
+
+// LoadVMwareSpecInto registers the information elements from the given specification in r
+func LoadVMwareSpecInto(r *Registry) {
+	r.Register(InformationElement{Name: "tenantProtocol", Pen: 0x1adc, ID: 0x370, Type: 1, Length: 0x1, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "tenantSourceIPv4", Pen: 0x1adc, ID: 0x371, Type: 18, Length: 0x4})
+	r.Register(InformationElement{Name: "tenantDestIPv4", Pen: 0x1adc, ID: 0x372, Type: 18, Length: 0x4})
+	r.Register(InformationElement{Name: "tenantSourceIPv6", Pen: 0x1adc, ID: 0x373, Type: 19, Length: 0x10})
+	r.Register(InformationElement{Name: "tenantDestIPv6", Pen: 0x1adc, ID: 0x374, Type: 19, Length: 0x10})
+	r.Register(InformationElement{Name: "tenantSourcePort", Pen: 0x1adc, ID: 0x376, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "tenantDestPort", Pen: 0x1adc, ID: 0x377, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "egressInterfaceAttr", Pen: 0x1adc, ID: 0x378, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "vxlanExportRole", Pen: 0x1adc, ID: 0x379, Type: 1, Length: 0x1, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "ingressInterfaceAttr", Pen: 0x1adc, ID: 0x37a, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+}
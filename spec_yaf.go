@@ -0,0 +1,57 @@
+package ipfix
+
+// GENERATED BY generate_spec; DO NOT CHANGE!
+
+import "sync"
+
+var onceLoadYAFSpec sync.Once
+
+// LoadYAFSpec loads information elements from the given specification into the DefaultRegistry.
+// It is safe to call LoadYAFSpec several times and concurrently.
+func LoadYAFSpec() {
+	onceLoadYAFSpec.Do(func() {
+		LoadYAFSpecInto(DefaultRegistry)
+	})
+}
+
+// LoadYAFSpecInto registers the information elements from the given specification in r
+func LoadYAFSpecInto(r *Registry) {
+	r.Register(InformationElement{Name: "initialTCPFlags", Pen: 0x1ad7, ID: 0xe, Type: 1, Length: 0x1, Semantics: FlagsSemantics})
+	r.Register(InformationElement{Name: "unionTCPFlags", Pen: 0x1ad7, ID: 0xf, Type: 1, Length: 0x1, Semantics: FlagsSemantics})
+	r.Register(InformationElement{Name: "payload", Pen: 0x1ad7, ID: 0x12, Type: 0, Length: 0xffff})
+	r.Register(InformationElement{Name: "reverseFlowDeltaMilliseconds", Pen: 0x1ad7, ID: 0x15, Type: 3, Length: 0x4, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "silkFlowType", Pen: 0x1ad7, ID: 0x1e, Type: 1, Length: 0x1, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "silkFlowSensor", Pen: 0x1ad7, ID: 0x1f, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "silkTCPState", Pen: 0x1ad7, ID: 0x20, Type: 1, Length: 0x1, Semantics: FlagsSemantics})
+	r.Register(InformationElement{Name: "silkAppLabel", Pen: 0x1ad7, ID: 0x21, Type: 2, Length: 0x2, Semantics: IdentifierSemantics})
+	r.Register(InformationElement{Name: "payloadEntropy", Pen: 0x1ad7, ID: 0x23, Type: 1, Length: 0x1})
+	r.Register(InformationElement{Name: "osName", Pen: 0x1ad7, ID: 0x24, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "osVersion", Pen: 0x1ad7, ID: 0x25, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "firstPacketBanner", Pen: 0x1ad7, ID: 0x26, Type: 0, Length: 0xffff})
+	r.Register(InformationElement{Name: "secondPacketBanner", Pen: 0x1ad7, ID: 0x27, Type: 0, Length: 0xffff})
+	r.Register(InformationElement{Name: "flowAttributes", Pen: 0x1ad7, ID: 0x28, Type: 2, Length: 0x2, Semantics: FlagsSemantics})
+	r.Register(InformationElement{Name: "expiredFragmentCount", Pen: 0x1ad7, ID: 0x64, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics})
+	r.Register(InformationElement{Name: "assembledFragmentCount", Pen: 0x1ad7, ID: 0x65, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics})
+	r.Register(InformationElement{Name: "meanFlowRate", Pen: 0x1ad7, ID: 0x66, Type: 3, Length: 0x4})
+	r.Register(InformationElement{Name: "meanPacketRate", Pen: 0x1ad7, ID: 0x67, Type: 3, Length: 0x4})
+	r.Register(InformationElement{Name: "flowTableFlushEventCount", Pen: 0x1ad7, ID: 0x68, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics})
+	r.Register(InformationElement{Name: "flowTablePeakCount", Pen: 0x1ad7, ID: 0x69, Type: 3, Length: 0x4})
+	r.Register(InformationElement{Name: "yafFlowKeyHash", Pen: 0x1ad7, ID: 0x6a, Type: 3, Length: 0x4})
+	r.Register(InformationElement{Name: "osFingerPrint", Pen: 0x1ad7, ID: 0x6b, Type: 13, Length: 0xffff})
+	r.Register(InformationElement{Name: "smallPacketCount", Pen: 0x1ad7, ID: 0x1f4, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics, Units: "packets"})
+	r.Register(InformationElement{Name: "nonEmptyPacketCount", Pen: 0x1ad7, ID: 0x1f5, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics, Units: "packets"})
+	r.Register(InformationElement{Name: "dataByteCount", Pen: 0x1ad7, ID: 0x1f6, Type: 4, Length: 0x8, Semantics: TotalCounterSemantics, Units: "octets"})
+	r.Register(InformationElement{Name: "averageInterarrivalTime", Pen: 0x1ad7, ID: 0x1f7, Type: 4, Length: 0x8, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "standardDeviationInterarrivalTime", Pen: 0x1ad7, ID: 0x1f8, Type: 4, Length: 0x8, Units: "milliseconds"})
+	r.Register(InformationElement{Name: "firstNonEmptyPacketSize", Pen: 0x1ad7, ID: 0x1f9, Type: 2, Length: 0x2, Units: "octets"})
+	r.Register(InformationElement{Name: "maxPacketSize", Pen: 0x1ad7, ID: 0x1fa, Type: 2, Length: 0x2, Units: "octets"})
+	r.Register(InformationElement{Name: "firstEightNonEmptyPacketDirections", Pen: 0x1ad7, ID: 0x1fb, Type: 1, Length: 0x1, Semantics: FlagsSemantics})
+	r.Register(InformationElement{Name: "standardDeviationPayloadLength", Pen: 0x1ad7, ID: 0x1fc, Type: 2, Length: 0x2, Units: "octets"})
+	r.Register(InformationElement{Name: "tcpUrgCount", Pen: 0x1ad7, ID: 0x1fd, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics, Units: "packets"})
+	r.Register(InformationElement{Name: "largePacketCount", Pen: 0x1ad7, ID: 0x1fe, Type: 3, Length: 0x4, Semantics: TotalCounterSemantics, Units: "packets"})
+	r.Register(InformationElement{Name: "reverseInitialTCPFlags", Pen: 0x1ad7, ID: 0x400e, Type: 1, Length: 0x1, Semantics: FlagsSemantics})
+	r.Register(InformationElement{Name: "reverseUnionTCPFlags", Pen: 0x1ad7, ID: 0x400f, Type: 1, Length: 0x1, Semantics: FlagsSemantics})
+	r.Register(InformationElement{Name: "reversePayload", Pen: 0x1ad7, ID: 0x4012, Type: 0, Length: 0xffff})
+	r.Register(InformationElement{Name: "reversePayloadEntropy", Pen: 0x1ad7, ID: 0x4023, Type: 1, Length: 0x1})
+	r.Register(InformationElement{Name: "reverseFlowAttributes", Pen: 0x1ad7, ID: 0x4028, Type: 2, Length: 0x2, Semantics: FlagsSemantics})
+}
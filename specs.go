@@ -1,3 +1,9 @@
 package ipfix
 
 //go:generate go run generate_spec.go LoadIANASpec spec_iana.xml
+//go:generate go run generate_registries.go spec_iana.xml registries_iana.go
+//go:generate go run generate_spec.go LoadYAFSpec spec_yaf.iespec
+//go:generate go run generate_spec.go LoadNtopSpec spec_ntop.iespec
+//go:generate go run generate_spec.go LoadVMwareSpec spec_vmware.iespec
+//go:generate go run generate_spec.go LoadCiscoAVCSpec spec_cisco_avc.iespec
+//go:generate go run generate_spec.go LoadBarracudaSpec spec_barracuda.iespec
@@ -0,0 +1,65 @@
+package ipfix
+
+import "math"
+
+// strictInteger returns a RangeError if value would change while converting it to the integer type t
+// encoded with length bytes. This includes negative values for unsigned types, unsigned values exceeding
+// the range of signed types, and floats that are NaN, infinite, or out of range. Overflows of the encoded
+// length are checked by serializeUnsignedTo regardless of strict mode.
+func strictInteger(t Type, value interface{}, length int) error {
+	if t == BooleanType {
+		return nil
+	}
+	if length == 0 {
+		length = int(DefaultSize[t])
+	}
+	switch v := value.(type) {
+	case float64:
+		return strictFloat(t, v, value, length)
+	case float32:
+		return strictFloat(t, float64(v), value, length)
+	case int64:
+		return strictSign(t, uint64(v), true, length)
+	case int32:
+		return strictSign(t, uint64(v), true, length)
+	case int16:
+		return strictSign(t, uint64(v), true, length)
+	case int8:
+		return strictSign(t, uint64(v), true, length)
+	case int:
+		return strictSign(t, uint64(v), true, length)
+	case uint64:
+		return strictSign(t, v, false, length)
+	case uint:
+		return strictSign(t, uint64(v), false, length)
+	}
+	return nil
+}
+
+// strictSign checks that the sign of val, which is sign-extended if signed is true, is preserved by the
+// integer type t.
+func strictSign(t Type, val uint64, signed bool, length int) error {
+	r := integerRange(t, length)
+	if signed == (r.Min < 0) {
+		return nil
+	}
+	if signed && int64(val) < 0 {
+		return RangeError{value: int64(val), min: r.Min, max: r.Max}
+	}
+	if !signed && val > math.MaxInt64 {
+		return RangeError{value: val, min: r.Min, max: r.Max}
+	}
+	return nil
+}
+
+// strictFloat checks that the float val can be converted to the integer type t without overflow.
+// Fractions are truncated, but negative values are rejected for unsigned types.
+func strictFloat(t Type, val float64, value interface{}, length int) error {
+	r := integerRange(t, length)
+	// float64(r.Max)+1 rounds to 2^64 and 2^63 for the 64 bit types, which are the first values that
+	// don't fit
+	if math.IsNaN(val) || val < float64(r.Min) || val >= float64(r.Max)+1 {
+		return RangeError{value: value, min: r.Min, max: r.Max}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package ipfix_test
+
+import (
+	"io/ioutil"
+	"math"
+	"testing"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+func TestStrictSendData(t *testing.T) {
+	unsigned := ipfix.NewInformationElement("u", 0, 1, ipfix.Unsigned64Type, 0)
+	signed := ipfix.NewInformationElement("s", 0, 2, ipfix.Signed64Type, 0)
+	small := ipfix.NewInformationElement("b", 0, 3, ipfix.Unsigned16Type, 1)
+	for _, test := range []struct {
+		ie     ipfix.InformationElement
+		value  interface{}
+		strict bool
+	}{
+		{unsigned, -1, true},
+		{unsigned, int64(-1), true},
+		{unsigned, -0.5, true},
+		{unsigned, math.NaN(), true},
+		{unsigned, math.Inf(1), true},
+		{unsigned, 1e20, true},
+		{unsigned, float32(-1), true},
+		{signed, uint64(math.MaxUint64), true},
+		{signed, math.Inf(-1), true},
+		{signed, math.Ldexp(1, 63), true},
+		{small, 256, false},
+		{small, -1, false},
+		{small, 256.5, false},
+	} {
+		for _, strict := range []bool{false, true} {
+			s, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.Strict = strict
+			id, err := s.AddTemplate(0, test.ie)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.SendData(0, id, test.value)
+			if !strict && test.strict {
+				if err != nil {
+					t.Errorf("%s %v: unexpected error in non-strict mode: %v", test.ie.Name, test.value, err)
+				}
+				continue
+			}
+			rerr, ok := err.(ipfix.RangeError)
+			if !ok {
+				t.Errorf("%s %v (strict %v): expected RangeError, got %v", test.ie.Name, test.value, strict, err)
+				continue
+			}
+			if rerr.Element().Name != test.ie.Name {
+				t.Errorf("%s %v: error names element %s", test.ie.Name, test.value, rerr.Element().Name)
+			}
+		}
+	}
+}
+
+func TestStrictSendDataValid(t *testing.T) {
+	s, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Strict = true
+	id, err := s.AddTemplate(0,
+		ipfix.NewInformationElement("u", 0, 1, ipfix.Unsigned64Type, 0),
+		ipfix.NewInformationElement("s", 0, 2, ipfix.Signed64Type, 0),
+		ipfix.NewInformationElement("f", 0, 3, ipfix.Float64Type, 0),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, values := range [][]interface{}{
+		{uint64(math.MaxUint64), int64(math.MinInt64), math.NaN()},
+		{1.5, -1.5, math.Inf(1)},
+		{math.Ldexp(1, 63), math.Ldexp(-1, 63), -1},
+		{true, uint64(math.MaxInt64), 0},
+	} {
+		if err := s.SendData(0, id, values...); err != nil {
+			t.Errorf("%v: %v", values, err)
+		}
+	}
+}
+
+func TestStrictRangeError(t *testing.T) {
+	s, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Strict = true
+	ie := ipfix.NewInformationElement("u", 35566, 1, ipfix.Unsigned32Type, 0)
+	id, err := s.AddTemplate(0, ie)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.SendData(0, id, int8(-3))
+	rerr, ok := err.(ipfix.RangeError)
+	if !ok {
+		t.Fatalf("expected RangeError, got %v", err)
+	}
+	min, max := rerr.Range()
+	if rerr.Value() != int64(-3) || min != int64(0) || max != uint64(math.MaxUint32) {
+		t.Errorf("unexpected range error %v (%v, [%v, %v])", err, rerr.Value(), min, max)
+	}
+	if want := "ipfix: Value -3 out of range [0, 4294967295] of u(35566/1)<unsigned32>"; err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestStrictBasicList(t *testing.T) {
+	list := ipfix.NewBasicList("l", ipfix.NewInformationElement("u", 0, 1, ipfix.Unsigned64Type, 0), 0)
+	for _, value := range []interface{}{
+		[]int64{1, -1},
+		[]int{-1},
+		[]float64{math.NaN()},
+		[]interface{}{1, -1},
+	} {
+		for _, strict := range []bool{false, true} {
+			s, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.Strict = strict
+			id, err := s.AddTemplate(0, list)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.SendData(0, id, value)
+			if _, ok := err.(ipfix.RangeError); ok != strict {
+				t.Errorf("%v (strict %v): unexpected error %v", value, strict, err)
+			}
+		}
+	}
+}
+
+func TestStrictSendStruct(t *testing.T) {
+	type flow struct {
+		Unsigned int     `ipfix:"u(35566/1)<unsigned64>"`
+		Signed   uint64  `ipfix:"s(35566/2)<signed64>"`
+		Float    float64 `ipfix:"f(35566/3)<unsigned32>"`
+	}
+	for _, test := range []struct {
+		value flow
+		err   bool
+	}{
+		{flow{1, 1, 1}, false},
+		{flow{-1, 1, 1}, true},
+		{flow{1, math.MaxUint64, 1}, true},
+		{flow{1, 1, -1}, true},
+		{flow{1, 1, math.Inf(1)}, true},
+	} {
+		s, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Strict = true
+		id, err := s.AddStructTemplate(0, flow{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.SendStruct(0, id, &test.value)
+		if _, ok := err.(ipfix.RangeError); ok != test.err {
+			t.Errorf("%+v: unexpected error %v", test.value, err)
+		}
+	}
+}
@@ -0,0 +1,340 @@
+package ipfix
+
+import (
+	"net/netip"
+	"reflect"
+	"time"
+	"unsafe"
+)
+
+// structMapping maps the tagged fields of a struct type to information elements
+type structMapping struct {
+	t        reflect.Type
+	elements []InformationElement
+	fields   []structField
+	// version is the version of the Registry the tags were resolved in
+	version uint64
+}
+
+// structField writes a single field of a struct
+type structField struct {
+	offset uintptr
+	encode func(buffer scratchBuffer, p unsafe.Pointer) error
+}
+
+var (
+	timeType   = reflect.TypeOf(time.Time{})
+	addrType   = reflect.TypeOf(netip.Addr{})
+	prefixType = reflect.TypeOf(netip.Prefix{})
+)
+
+// getStructMapping returns the mapping of the given struct type with tags resolved in registry from the
+// cache of registry or creates it, if it is missing or elements were registered since.
+func getStructMapping(t reflect.Type, registry *Registry) (*structMapping, error) {
+	version := registry.versions()
+	if mapping, ok := registry.structs.Load(t); ok && mapping.(*structMapping).version == version {
+		return mapping.(*structMapping), nil
+	}
+	mapping, err := makeStructMapping(t, registry)
+	if err != nil {
+		return nil, err
+	}
+	mapping.version = version
+	registry.structs.Store(t, mapping)
+	return mapping, nil
+}
+
+func makeStructMapping(t reflect.Type, registry *Registry) (*structMapping, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, StructError{t, "", "not a struct"}
+	}
+	mapping := &structMapping{t: t}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("ipfix")
+		if !ok || tag == "-" {
+			continue
+		}
+		if field.PkgPath != "" {
+			return nil, StructError{t, field.Name, "field is not exported"}
+		}
+		ie, err := registry.MakeIEFromSpec([]byte(tag))
+		if err != nil {
+			return nil, StructError{t, field.Name, err.Error()}
+		}
+		mapping.elements = append(mapping.elements, ie)
+		mapping.fields = append(mapping.fields, structField{field.Offset, makeFieldEncoder(field.Type, ie)})
+		if field.Type == prefixType {
+			// prefixes fill address and prefixLength pairs
+			if length, ok := ie.prefixLengthElement(registry); ok {
+				mapping.elements = append(mapping.elements, length)
+				mapping.fields = append(mapping.fields, structField{field.Offset, makeFieldEncoder(field.Type, length)})
+			}
+		}
+	}
+	if len(mapping.elements) == 0 {
+		return nil, StructError{t, "", "no fields with ipfix tag"}
+	}
+	return mapping, nil
+}
+
+// makeFieldEncoder returns a function that writes a field of type t with the information element ie.
+// Common combinations are written without reflection; all the others are converted like values passed
+// to SendData.
+func makeFieldEncoder(t reflect.Type, ie InformationElement) func(buffer scratchBuffer, p unsafe.Pointer) error {
+	length := int(ie.Length)
+	switch ie.Type {
+	case Unsigned8Type, Unsigned16Type, Unsigned32Type, Unsigned64Type, Signed8Type, Signed16Type, Signed32Type, Signed64Type:
+		if t == prefixType {
+			return func(buffer scratchBuffer, p unsafe.Pointer) error {
+				prefix := (*netip.Prefix)(p)
+				if !prefix.IsValid() {
+					return ConversionError{ie.Type, *prefix}
+				}
+				_, err := serializeUnsignedTo(buffer, ie.Type, uint64(prefix.Bits()), false, length)
+				return err
+			}
+		}
+		if read := integerReader(t.Kind()); read != nil {
+			signed := false
+			switch t.Kind() {
+			case reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8, reflect.Int:
+				signed = true
+			}
+			return func(buffer scratchBuffer, p unsafe.Pointer) error {
+				val := read(p)
+				if buffer.strict() {
+					if err := strictSign(ie.Type, val, signed, length); err != nil {
+						return err
+					}
+				}
+				_, err := serializeUnsignedTo(buffer, ie.Type, val, signed, length)
+				return err
+			}
+		}
+	case BooleanType:
+		if t.Kind() == reflect.Bool {
+			return func(buffer scratchBuffer, p unsafe.Pointer) error {
+				var val uint64 = 2
+				if *(*bool)(p) {
+					val = 1
+				}
+				_, err := serializeUnsignedTo(buffer, ie.Type, val, false, length)
+				return err
+			}
+		}
+	case Float32Type, Float64Type:
+		var read func(p unsafe.Pointer) float64
+		switch t.Kind() {
+		case reflect.Float32:
+			read = func(p unsafe.Pointer) float64 { return float64(*(*float32)(p)) }
+		case reflect.Float64:
+			read = func(p unsafe.Pointer) float64 { return *(*float64)(p) }
+		}
+		if read != nil {
+			if ie.Type == Float32Type {
+				return func(buffer scratchBuffer, p unsafe.Pointer) error {
+					_, err := serializeFloat32To(buffer, float32(read(p)))
+					return err
+				}
+			}
+			return func(buffer scratchBuffer, p unsafe.Pointer) error {
+				_, err := serializeFloat64To(buffer, ie.Type, read(p), length)
+				return err
+			}
+		}
+	case OctetArrayType, Ipv4AddressType, Ipv6AddressType, MacAddressType, StringType:
+		switch {
+		case t == addrType:
+			return func(buffer scratchBuffer, p unsafe.Pointer) error {
+				_, err := serializeAddrTo(buffer, ie.Type, *(*netip.Addr)(p), length)
+				return err
+			}
+		case t == prefixType:
+			return func(buffer scratchBuffer, p unsafe.Pointer) error {
+				_, err := serializeAddrTo(buffer, ie.Type, (*netip.Prefix)(p).Masked().Addr(), length)
+				return err
+			}
+		case t.Kind() == reflect.String:
+			return func(buffer scratchBuffer, p unsafe.Pointer) error {
+				val := []byte(*(*string)(p))
+				if !octetsFit(ie.Type, val, length) {
+					return ConversionError{ie.Type, reflect.NewAt(t, p).Elem().Interface()}
+				}
+				_, err := serializeOctetsTo(buffer, val, length)
+				return err
+			}
+		case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+			// []byte, net.IP, net.HardwareAddr
+			return func(buffer scratchBuffer, p unsafe.Pointer) error {
+				val := *(*[]byte)(p)
+				if !octetsFit(ie.Type, val, length) {
+					return ConversionError{ie.Type, reflect.NewAt(t, p).Elem().Interface()}
+				}
+				_, err := serializeOctetsTo(buffer, val, length)
+				return err
+			}
+		}
+	case DateTimeSecondsType, DateTimeMillisecondsType, DateTimeMicrosecondsType, DateTimeNanosecondsType:
+		if t == timeType {
+			return func(buffer scratchBuffer, p unsafe.Pointer) error {
+				v := (*time.Time)(p)
+				_, err := serializeTimeTo(buffer, ie.Type, uint64(v.Unix()), uint64(v.Nanosecond()))
+				return err
+			}
+		}
+	}
+	return func(buffer scratchBuffer, p unsafe.Pointer) error {
+		return ie.serializeDataTo(buffer, reflect.NewAt(t, p).Elem().Interface())
+	}
+}
+
+// integerReader returns a function that reads an integer of the given kind as uint64, or nil if kind is
+// not an integer.
+func integerReader(kind reflect.Kind) func(p unsafe.Pointer) uint64 {
+	switch kind {
+	case reflect.Uint64:
+		return func(p unsafe.Pointer) uint64 { return *(*uint64)(p) }
+	case reflect.Uint32:
+		return func(p unsafe.Pointer) uint64 { return uint64(*(*uint32)(p)) }
+	case reflect.Uint16:
+		return func(p unsafe.Pointer) uint64 { return uint64(*(*uint16)(p)) }
+	case reflect.Uint8:
+		return func(p unsafe.Pointer) uint64 { return uint64(*(*uint8)(p)) }
+	case reflect.Uint:
+		return func(p unsafe.Pointer) uint64 { return uint64(*(*uint)(p)) }
+	case reflect.Int64:
+		return func(p unsafe.Pointer) uint64 { return uint64(*(*int64)(p)) }
+	case reflect.Int32:
+		return func(p unsafe.Pointer) uint64 { return uint64(*(*int32)(p)) }
+	case reflect.Int16:
+		return func(p unsafe.Pointer) uint64 { return uint64(*(*int16)(p)) }
+	case reflect.Int8:
+		return func(p unsafe.Pointer) uint64 { return uint64(*(*int8)(p)) }
+	case reflect.Int:
+		return func(p unsafe.Pointer) uint64 { return uint64(*(*int)(p)) }
+	}
+	return nil
+}
+
+// assignStruct writes the struct at p as data record of this template.
+func (t template) assignStruct(record *recordBuffer, mapping *structMapping, p unsafe.Pointer) error {
+	good := record.length()
+	record.template = t.identifier
+	for i, field := range mapping.fields {
+		if err := field.encode(record, unsafe.Pointer(uintptr(p)+field.offset)); err != nil {
+			record.reset(good)
+			return mapping.elements[i].elementError(err)
+		}
+	}
+	return nil
+}
+
+// structType returns the struct type of v, which can be a struct, a pointer to a struct, or a slice of
+// either.
+func structType(v interface{}) reflect.Type {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// AddStructTemplate adds a new template with the information elements of the tagged fields of the
+// given struct, which can also be passed as pointer (e.g. (*Flow)(nil)). Fields are tagged with the
+// name of a registered information element, optionally followed by a reduced length, or a full iespec:
+//
+//	type Flow struct {
+//		Bytes   uint64    `ipfix:"octetDeltaCount"`
+//		Packets uint32    `ipfix:"packetDeltaCount[4]"`
+//		Start   time.Time `ipfix:"flowStartMilliseconds"`
+//		Custom  uint32    `ipfix:"myField(35566/1)<unsigned32>"`
+//		Ignored int
+//	}
+//
+// Untagged fields and fields tagged with "-" are ignored. A netip.Prefix field tagged with an iana
+// address element like sourceIPv4Address also fills the matching prefix length element like
+// sourceIPv4PrefixLength, which follows the address in the template. The mapping is computed once per type
+// and cached in the Registry until further elements are registered.
+// now must be the current or exported time either as a time.Time value or as one of the provided ipfix
+// time types. A template id is returned that can be used with SendStruct.
+func (m *MessageStream) AddStructTemplate(now interface{}, v interface{}) (id int, err error) {
+	t := structType(v)
+	if t == nil {
+		return 0, StructError{t, "", "not a struct"}
+	}
+	mapping, err := getStructMapping(t, m.registry())
+	if err != nil {
+		return 0, err
+	}
+	elements := make([]InformationElement, len(mapping.elements))
+	copy(elements, mapping.elements)
+	return m.addTemplate(now, 0, elements)
+}
+
+// SendStruct sends the given struct value as data record for the given template id. v can be a struct,
+// a pointer to a struct, or a slice of either, which sends one data record per element. The information
+// elements of the tagged fields must match the template, which is the case for templates created by
+// AddStructTemplate from the same type. Passing pointers or slices avoids copying the struct.
+func (m *MessageStream) SendStruct(now interface{}, template int, v interface{}) error {
+	t := m.lookupTemplate(template)
+	if t == nil {
+		return UnknownTemplateError(template)
+	}
+	st := structType(v)
+	if st == nil {
+		return StructError{st, "", "not a struct"}
+	}
+	mapping, err := getStructMapping(st, m.registry())
+	if err != nil {
+		return err
+	}
+	if t.mapping != mapping {
+		if err := t.matches(mapping.elements); err != nil {
+			return err
+		}
+		t.mapping = mapping
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Struct:
+		copied := reflect.New(st)
+		copied.Elem().Set(value)
+		return m.sendStruct(now, t, mapping, unsafe.Pointer(copied.Pointer()))
+	case reflect.Ptr:
+		if value.IsNil() {
+			return StructError{st, "", "nil pointer"}
+		}
+		return m.sendStruct(now, t, mapping, unsafe.Pointer(value.Pointer()))
+	}
+	for i := 0; i < value.Len(); i++ {
+		element := value.Index(i)
+		var p unsafe.Pointer
+		if element.Kind() == reflect.Ptr {
+			if element.IsNil() {
+				return StructError{st, "", "nil pointer"}
+			}
+			p = unsafe.Pointer(element.Pointer())
+		} else {
+			p = unsafe.Pointer(element.UnsafeAddr())
+		}
+		if err := m.sendStruct(now, t, mapping, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MessageStream) sendStruct(now interface{}, t *template, mapping *structMapping, p unsafe.Pointer) error {
+	m.currentDataRecord.strictConversion = m.Strict
+	if err := t.assignStruct(&m.currentDataRecord, mapping, p); err != nil {
+		return err
+	}
+	return m.sendRecord(&m.currentDataRecord, now)
+}
@@ -0,0 +1,266 @@
+package ipfix_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	ipfix "github.com/CN-TU/go-ipfix"
+)
+
+type testFlow struct {
+	Source  net.IP    `ipfix:"sourceIPv4Address"`
+	Bytes   uint64    `ipfix:"octetDeltaCount"`
+	Packets int       `ipfix:"packetDeltaCount[4]"`
+	Start   time.Time `ipfix:"flowStartMilliseconds"`
+	Rate    float32   `ipfix:"samplingProbability"`
+	App     string    `ipfix:"applicationName"`
+	Custom  uint16    `ipfix:"myField(35566/1)<unsigned32>"`
+	Sizes   []uint16  `ipfix:"-"`
+	Comment string
+}
+
+func ExampleMessageStream_AddStructTemplate() {
+	// output of this example will be in buf
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC) // simulated fixed time
+
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+
+	// Fields are tagged with information element names or iespecs
+	type flow struct {
+		Bytes   uint64    `ipfix:"octetDeltaCount"`
+		Packets uint64    `ipfix:"packetDeltaCount[4]"`
+		Start   time.Time `ipfix:"flowStartSeconds"`
+		Custom  uint8     `ipfix:"myField(35566/1)<unsigned8>"`
+		Comment string
+	}
+
+	id, err := msgStream.AddStructTemplate(now, flow{})
+	if err != nil {
+		fmt.Println("MessageStream.AddStructTemplate failed:", err)
+		return
+	}
+
+	// Send a single struct
+	if err := msgStream.SendStruct(now, id, &flow{Bytes: 1500, Packets: 1, Start: now, Custom: 7}); err != nil {
+		fmt.Println("MessageStream.SendStruct failed:", err)
+		return
+	}
+	// or a slice of structs
+	flows := []flow{
+		{Bytes: 60, Packets: 1, Start: now, Custom: 8},
+		{Bytes: 3000, Packets: 2, Start: now.Add(time.Second), Custom: 9},
+	}
+	if err := msgStream.SendStruct(now, id, flows); err != nil {
+		fmt.Println("MessageStream.SendStruct failed:", err)
+		return
+	}
+	if err := msgStream.Flush(now); err != nil {
+		fmt.Println("MessageStream.Flush failed:", err)
+		return
+	}
+
+	// buf holds now the complete ipfix data of this example
+	fmt.Printf("% x", buf.Bytes())
+	// Output: 00 0a 00 63 5a 49 7a 00 00 00 00 00 00 00 00 00 00 02 00 1c 01 00 00 04 00 01 00 08 00 02 00 04 00 96 00 04 80 01 00 01 00 00 8a ee 01 00 00 37 00 00 00 00 00 00 05 dc 00 00 00 01 5a 49 7a 00 07 00 00 00 00 00 00 00 3c 00 00 00 01 5a 49 7a 00 08 00 00 00 00 00 00 0b b8 00 00 00 02 5a 49 7a 01 09
+}
+
+func TestSendStruct(t *testing.T) {
+	ipfix.LoadIANASpec()
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	flow := testFlow{
+		Source:  net.IP{10, 0, 0, 1},
+		Bytes:   1 << 40,
+		Packets: 1 << 20,
+		Start:   now.Add(1234 * time.Millisecond),
+		Rate:    0.5,
+		App:     "test",
+		Custom:  0xffff,
+		Sizes:   []uint16{1, 2},
+	}
+
+	// sending a struct must be the same as sending the values
+	structs := new(bytes.Buffer)
+	msgStream, err := ipfix.MakeMessageStream(structs, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := msgStream.AddStructTemplate(now, (*testFlow)(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []interface{}{flow, &flow, []testFlow{flow}, []*testFlow{&flow}} {
+		if err := msgStream.SendStruct(now, id, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	msgStream.Flush(now)
+
+	values := new(bytes.Buffer)
+	msgStream, err = ipfix.MakeMessageStream(values, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var elements []ipfix.InformationElement
+	for _, name := range []string{"sourceIPv4Address", "octetDeltaCount", "packetDeltaCount", "flowStartMilliseconds", "samplingProbability", "applicationName"} {
+		ie, err := ipfix.GetInformationElement(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		elements = append(elements, ie)
+	}
+	elements[2].Length = 4
+	custom, _ := ipfix.MakeIEFromSpec([]byte("myField(35566/1)<unsigned32>"))
+	elements = append(elements, custom)
+	id, err = msgStream.AddTemplate(now, elements...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 4; i++ {
+		if err := msgStream.SendData(now, id, flow.Source, flow.Bytes, flow.Packets, flow.Start, flow.Rate, flow.App, flow.Custom); err != nil {
+			t.Fatal(err)
+		}
+	}
+	msgStream.Flush(now)
+
+	if !bytes.Equal(structs.Bytes(), values.Bytes()) {
+		t.Errorf("SendStruct differs from SendData:\n% x\n% x", structs.Bytes(), values.Bytes())
+	}
+
+	// structs can also be sent with matching templates created by AddTemplate
+	if err := msgStream.SendStruct(now, id, &flow); err != nil {
+		t.Error(err)
+	}
+	other, err := msgStream.AddTemplate(now, elements[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msgStream.SendStruct(now, other, &flow); err == nil {
+		t.Error("SendStruct succeeded with mismatching template")
+	}
+}
+
+func TestSendStructErrors(t *testing.T) {
+	ipfix.LoadIANASpec()
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	msgStream, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type unknown struct {
+		A uint64 `ipfix:"noSuchElement"`
+	}
+	type untagged struct {
+		A uint64
+	}
+	type unexported struct {
+		a uint64 `ipfix:"octetDeltaCount"`
+	}
+	for _, v := range []interface{}{nil, 5, unknown{}, untagged{}, unexported{}} {
+		if _, err := msgStream.AddStructTemplate(now, v); err == nil {
+			t.Errorf("AddStructTemplate(%T) succeeded", v)
+		}
+	}
+	id, err := msgStream.AddStructTemplate(now, testFlow{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msgStream.SendStruct(now, id, (*testFlow)(nil)); err == nil {
+		t.Error("SendStruct succeeded with nil pointer")
+	}
+	if err := msgStream.SendStruct(now, id+1, &testFlow{}); err == nil {
+		t.Error("SendStruct succeeded with unknown template")
+	}
+	type address struct {
+		Source string `ipfix:"sourceIPv4Address"`
+	}
+	id, err = msgStream.AddStructTemplate(now, address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := msgStream.SendStruct(now, id, &address{"\x0a\x00\x00\x01\x02"}).(ipfix.ConversionError); !ok {
+		t.Error("SendStruct succeeded with oversized address")
+	}
+	if _, ok := msgStream.SendData(now, id, "\x0a\x00\x00\x01\x02").(ipfix.ConversionError); !ok {
+		t.Error("SendData succeeded with oversized address")
+	}
+}
+
+func TestSendStructAllocations(t *testing.T) {
+	ipfix.LoadIANASpec()
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	msgStream, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := msgStream.AddStructTemplate(now, testFlow{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	flow := &testFlow{Source: net.IP{10, 0, 0, 1}, Bytes: 1500, Start: now, App: "test"}
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := msgStream.SendStruct(now, id, flow); err != nil {
+			t.Fatal(err)
+		}
+		msgStream.Flush(now)
+	})
+	if allocs > 0 {
+		t.Errorf("SendStruct allocated %v times per record", allocs)
+	}
+}
+
+func BenchmarkSendStruct(b *testing.B) {
+	ipfix.LoadIANASpec()
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	msgStream, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	id, err := msgStream.AddStructTemplate(now, testFlow{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	flow := &testFlow{Source: net.IP{10, 0, 0, 1}, Bytes: 1500, Packets: 1, Start: now, App: "test"}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := msgStream.SendStruct(now, id, flow); err != nil {
+			b.Fatal(err)
+		}
+	}
+	msgStream.Flush(now)
+}
+
+func BenchmarkSendDataStruct(b *testing.B) {
+	ipfix.LoadIANASpec()
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC)
+	msgStream, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	id, err := msgStream.AddStructTemplate(now, testFlow{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	flow := &testFlow{Source: net.IP{10, 0, 0, 1}, Bytes: 1500, Packets: 1, Start: now, App: "test"}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := msgStream.SendData(now, id, flow.Source, flow.Bytes, flow.Packets, flow.Start, flow.Rate, flow.App, flow.Custom); err != nil {
+			b.Fatal(err)
+		}
+	}
+	msgStream.Flush(now)
+}
@@ -17,3 +17,46 @@ const (
 	// UndefinedSemantic as defined by RFC6313
 	UndefinedSemantic StructuredSemantic = 0xFF
 )
+
+func (s StructuredSemantic) String() string {
+	switch s {
+	case NoneOfSemantic:
+		return "noneOf"
+	case ExactlyOneOfSemantic:
+		return "exactlyOneOf"
+	case OneOrMoreOfSemantic:
+		return "oneOrMoreOf"
+	case AllOfSemantic:
+		return "allOf"
+	case OrderedSemantic:
+		return "ordered"
+	case UndefinedSemantic:
+		return "undefined"
+	}
+	return "unassigned"
+}
+
+// SemanticList overrides the semantic of a single basicList, subTemplateList, or subTemplateMultiList
+// value. Values holds the list as it would be passed to SendData without the override.
+type SemanticList struct {
+	Semantic StructuredSemantic
+	Values   interface{}
+}
+
+// listSemantic returns the semantic and the values of a list value, which can be a SemanticList.
+func listSemantic(value interface{}, semantic StructuredSemantic) (StructuredSemantic, interface{}) {
+	if v, ok := value.(SemanticList); ok {
+		return v.Semantic, v.Values
+	}
+	return semantic, value
+}
+
+// nameToStructuredSemantic converts the textual representation of s as returned by String.
+func nameToStructuredSemantic(x []byte) (StructuredSemantic, bool) {
+	for _, s := range []StructuredSemantic{NoneOfSemantic, ExactlyOneOfSemantic, OneOrMoreOfSemantic, AllOfSemantic, OrderedSemantic, UndefinedSemantic} {
+		if s.String() == string(x) {
+			return s, true
+		}
+	}
+	return UndefinedSemantic, false
+}
@@ -0,0 +1,172 @@
+package ipfix
+
+import (
+	"encoding/binary"
+	"reflect"
+)
+
+// subTemplate references the templates used by subTemplateLists and subTemplateMultiLists
+type subTemplate struct {
+	stream *MessageStream
+	// id is the template id of subTemplateLists; subTemplateMultiLists specify the template per value
+	id int
+}
+
+// TemplateRecords holds the data records of a single template inside a subTemplateMultiList.
+type TemplateRecords struct {
+	// Template is the template id as returned by AddTemplate
+	Template int
+	// Records holds a slice of data records, where every record is a slice of values matching the
+	// template, e.g., [][]interface{}.
+	Records interface{}
+}
+
+// NewSubTemplateList returns an InformationElement holding a subTemplateList according to RFC6313. All
+// the records in the list use the template with the given id, which must have been added to this stream.
+// Values for this element are slices of records, where every record is a slice of values like the data
+// passed to SendData, e.g., [][]interface{}. The semantic of the list is UndefinedSemantic, unless a
+// SemanticList is passed.
+func (m *MessageStream) NewSubTemplateList(name string, id int) (InformationElement, error) {
+	if m.lookupTemplate(id) == nil {
+		return InformationElement{}, UnknownTemplateError(id)
+	}
+	return InformationElement{
+		Name:    name,
+		Pen:     ianaPen,
+		ID:      subTemplateListID,
+		Type:    SubTemplateListType,
+		Length:  VariableLength,
+		subType: subTemplate{stream: m, id: id},
+	}, nil
+}
+
+// NewSubTemplateMultiList returns an InformationElement holding a subTemplateMultiList according to
+// RFC6313. Values for this element are []TemplateRecords, where every template must have been added to
+// this stream. The semantic of the list is UndefinedSemantic, unless a SemanticList is passed.
+func (m *MessageStream) NewSubTemplateMultiList(name string) InformationElement {
+	return InformationElement{
+		Name:    name,
+		Pen:     ianaPen,
+		ID:      subTemplateMultiListID,
+		Type:    SubTemplateMultiListType,
+		Length:  VariableLength,
+		subType: subTemplate{stream: m},
+	}
+}
+
+func (ie InformationElement) serializeSubTemplateListTo(buffer scratchBuffer, value interface{}) error {
+	st, ok := ie.subType.(subTemplate)
+	if !ok {
+		// only elements created with NewSubTemplateList or NewSubTemplateMultiList reference a stream
+		return ConversionError{ie.Type, value}
+	}
+	semantic, value := listSemantic(value, UndefinedSemantic)
+	t := st.stream.lookupTemplate(st.id)
+	if t == nil {
+		return UnknownTemplateError(st.id)
+	}
+	// RFC6313: variable length header (3 byte encoding), semantic, and template id
+	b, err := buffer.append(6)
+	if err != nil {
+		return err
+	}
+	_ = b[5]
+	b[0] = 0xff
+	b[3] = byte(semantic)
+	binary.BigEndian.PutUint16(b[4:6], uint16(st.id))
+	start := buffer.length()
+	if err := t.serializeRecordsTo(buffer, ie.Type, value); err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint16(b[1:3], uint16(buffer.length()-start+3))
+	return nil
+}
+
+func (ie InformationElement) serializeSubTemplateMultiListTo(buffer scratchBuffer, value interface{}) error {
+	st, ok := ie.subType.(subTemplate)
+	if !ok {
+		// only elements created with NewSubTemplateList or NewSubTemplateMultiList reference a stream
+		return ConversionError{ie.Type, value}
+	}
+	semantic, value := listSemantic(value, UndefinedSemantic)
+	var lists []TemplateRecords
+	switch v := value.(type) {
+	case []TemplateRecords:
+		lists = v
+	case TemplateRecords:
+		lists = []TemplateRecords{v}
+	case nil:
+		// empty list
+	default:
+		return ConversionError{ie.Type, value}
+	}
+	// RFC6313: variable length header (3 byte encoding) and semantic
+	b, err := buffer.append(4)
+	if err != nil {
+		return err
+	}
+	_ = b[3]
+	b[0] = 0xff
+	b[3] = byte(semantic)
+	start := buffer.length()
+	for _, list := range lists {
+		t := st.stream.lookupTemplate(list.Template)
+		if t == nil {
+			return UnknownTemplateError(list.Template)
+		}
+		// every template is preceded by template id and length
+		header, err := buffer.append(4)
+		if err != nil {
+			return err
+		}
+		_ = header[3]
+		binary.BigEndian.PutUint16(header[0:2], uint16(list.Template))
+		listStart := buffer.length()
+		if err := t.serializeRecordsTo(buffer, ie.Type, list.Records); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint16(header[2:4], uint16(buffer.length()-listStart+4))
+	}
+	binary.BigEndian.PutUint16(b[1:3], uint16(buffer.length()-start+1))
+	return nil
+}
+
+// serializeRecordsTo writes the given slice of data records. listType is only used for errors.
+func (t template) serializeRecordsTo(buffer scratchBuffer, listType Type, records interface{}) error {
+	switch records := records.(type) {
+	case nil:
+		return nil
+	case [][]interface{}:
+		for _, record := range records {
+			if err := t.serializeRecordTo(buffer, record); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	values := reflect.ValueOf(records)
+	for values.Kind() == reflect.Ptr {
+		values = values.Elem()
+	}
+	if values.Kind() != reflect.Slice && values.Kind() != reflect.Array {
+		return ConversionError{listType, records}
+	}
+	l := values.Len()
+	for i := 0; i < l; i++ {
+		record := values.Index(i)
+		for record.Kind() == reflect.Ptr || record.Kind() == reflect.Interface {
+			record = record.Elem()
+		}
+		if record.Kind() != reflect.Slice && record.Kind() != reflect.Array {
+			return ConversionError{listType, records}
+		}
+		data := make([]interface{}, record.Len())
+		for j := range data {
+			data[j] = record.Index(j).Interface()
+		}
+		if err := t.serializeRecordTo(buffer, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
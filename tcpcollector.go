@@ -0,0 +1,127 @@
+package ipfix
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+)
+
+// TCPCollector receives ipfix messages via TCP according to RFC7011 section 10.4. Every connection is
+// a separate transport session with its own templates, which are dropped once the connection is closed.
+type TCPCollector struct {
+	// ErrorHandler is called for messages and data sets that could not be decoded, and for failed
+	// connections. Can be nil.
+	ErrorHandler ErrorHandler
+	// NetIP decodes addresses as netip.Addr instead of net.IP. Must be set before calling Serve.
+	NetIP bool
+	// Registry is used for looking up the information elements of templates. nil uses the
+	// DefaultRegistry. Must be set before calling Serve.
+	Registry       *Registry
+	listener       net.Listener
+	handler        RecordHandler
+	maxConnections int
+	mutex          sync.Mutex
+	connections    map[net.Conn]struct{}
+}
+
+// MakeTCPCollector returns a TCPCollector, which accepts connections from l and calls handler for every
+// decoded data record. At most maxConnections connections are served at once; further exporters have
+// to wait until a connection is closed. 0 means no limit.
+func MakeTCPCollector(l net.Listener, handler RecordHandler, maxConnections int) *TCPCollector {
+	return &TCPCollector{
+		listener:       l,
+		handler:        handler,
+		maxConnections: maxConnections,
+		connections:    make(map[net.Conn]struct{}),
+	}
+}
+
+// Addr returns the local address of the collector.
+func (c *TCPCollector) Addr() net.Addr {
+	return c.listener.Addr()
+}
+
+// Serve accepts connections and dispatches the received messages until ctx is done or accepting
+// fails. On return, the listener and all connections are closed. nil is returned if ctx is done.
+// The handlers are called concurrently from one goroutine per connection.
+func (c *TCPCollector) Serve(ctx context.Context) error {
+	var wg sync.WaitGroup
+	var slots chan struct{}
+	if c.maxConnections > 0 {
+		slots = make(chan struct{}, c.maxConnections)
+	}
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		c.listener.Close()
+		c.mutex.Lock()
+		for conn := range c.connections {
+			conn.Close()
+		}
+		c.mutex.Unlock()
+	}()
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+	for {
+		if slots != nil {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+		conn, err := c.listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		c.mutex.Lock()
+		if ctx.Err() != nil {
+			c.mutex.Unlock()
+			conn.Close()
+			return nil
+		}
+		c.connections[conn] = struct{}{}
+		c.mutex.Unlock()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.serveConnection(ctx, conn)
+			c.mutex.Lock()
+			delete(c.connections, conn)
+			c.mutex.Unlock()
+			conn.Close()
+			if slots != nil {
+				<-slots
+			}
+		}()
+	}
+}
+
+// serveConnection reads messages from conn until the connection is closed or the stream is corrupt.
+func (c *TCPCollector) serveConnection(ctx context.Context, conn net.Conn) {
+	exporter := conn.RemoteAddr()
+	cache := MakeTemplateCache()
+	cache.NetIP = c.NetIP
+	cache.Registry = c.Registry
+	reader := MakeMessageReader(conn)
+	for {
+		msg, err := reader.ReadMessage()
+		if err != nil {
+			// message boundaries are lost after an error, so the session can't be continued
+			if err != io.EOF && ctx.Err() == nil && c.ErrorHandler != nil {
+				c.ErrorHandler(exporter, err)
+			}
+			return
+		}
+		dispatchMessage(cache, nil, exporter, msg, c.handler, c.ErrorHandler)
+	}
+}
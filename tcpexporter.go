@@ -0,0 +1,237 @@
+package ipfix
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+)
+
+// ConnectionState is the state of the connection of a TCPExporter.
+type ConnectionState int
+
+const (
+	// Disconnected means no connection to the collector exists
+	Disconnected ConnectionState = iota
+	// Connected means the connection to the collector is established
+	Connected
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case Disconnected:
+		return "disconnected"
+	case Connected:
+		return "connected"
+	}
+	return "unknownState"
+}
+
+// DisconnectPolicy specifies what happens to messages written while a TCPExporter is disconnected.
+type DisconnectPolicy int
+
+const (
+	// DropWhileDisconnected drops all messages while no connection exists. The data records of dropped
+	// messages are not counted in the sequence number.
+	DropWhileDisconnected DisconnectPolicy = iota
+	// BufferWhileDisconnected keeps up to BufferSize bytes of messages, which are sent after reconnecting.
+	// Messages exceeding this limit are dropped. The data records of dropped messages are not counted in
+	// the sequence number.
+	BufferWhileDisconnected
+)
+
+const (
+	// DefaultMinBackoff is the default time to wait before reconnecting after the first failure
+	DefaultMinBackoff = 1 * time.Second
+	// DefaultMaxBackoff is the default maximum time to wait before reconnecting
+	DefaultMaxBackoff = 1 * time.Minute
+	// DefaultBufferSize is the default number of bytes buffered with BufferWhileDisconnected
+	DefaultBufferSize = 1 << 20
+)
+
+// writerFunc turns a function into an io.Writer
+type writerFunc func([]byte) (int, error)
+
+func (f writerFunc) Write(b []byte) (int, error) {
+	return f(b)
+}
+
+// TCPExporter is a MessageStream that exports via TCP according to RFC7011 section 10.4. If the
+// connection fails, the exporter reconnects with exponential backoff when the next message is written
+// and resends all templates at the start of the new connection.
+//
+// Connection attempts and writes happen synchronously from Flush or from a SendData, which needs to
+// flush a full message.
+type TCPExporter struct {
+	*MessageStream
+	// Policy specifies what happens to messages while disconnected
+	Policy DisconnectPolicy
+	// BufferSize is the maximum number of bytes buffered with BufferWhileDisconnected. 0 or less means
+	// DefaultBufferSize.
+	BufferSize int
+	// MinBackoff is the time to wait before reconnecting after the first failure
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum time to wait before reconnecting
+	MaxBackoff time.Duration
+	// DialTimeout limits the time for a connection attempt. 0 means no limit.
+	DialTimeout time.Duration
+	// StateHandler is called after a connection was established or lost, and after every failed
+	// connection attempt with the cause. Can be nil.
+	StateHandler func(state ConnectionState, err error)
+	address      string
+	mtu          uint16
+	conn         net.Conn
+	backoff      time.Duration
+	nextAttempt  time.Time
+	buffered     [][]byte
+	bufferedSize int
+	dropped      int
+}
+
+// MakeTCPExporter returns a TCPExporter, which connects to the given address and uses the given mtu
+// size. The observationID is used as the observation id in the ipfix messages. The connection is
+// established when the first message is written.
+func MakeTCPExporter(address string, mtu uint16, observationID uint32) (*TCPExporter, error) {
+	e := &TCPExporter{
+		BufferSize: DefaultBufferSize,
+		MinBackoff: DefaultMinBackoff,
+		MaxBackoff: DefaultMaxBackoff,
+		address:    address,
+		mtu:        mtu,
+	}
+	stream, err := MakeMessageStream(writerFunc(e.write), mtu, observationID)
+	if err != nil {
+		return nil, err
+	}
+	e.MessageStream = stream
+	return e, nil
+}
+
+// State returns the current connection state.
+func (e *TCPExporter) State() ConnectionState {
+	if e.conn == nil {
+		return Disconnected
+	}
+	return Connected
+}
+
+// DroppedMessages returns the number of messages that were dropped while disconnected.
+func (e *TCPExporter) DroppedMessages() int {
+	return e.dropped
+}
+
+// Close flushes the stream and closes the connection.
+func (e *TCPExporter) Close(now interface{}) error {
+	err := e.Flush(now)
+	if e.conn != nil {
+		if cerr := e.conn.Close(); err == nil {
+			err = cerr
+		}
+		e.conn = nil
+	}
+	return err
+}
+
+func (e *TCPExporter) notify(state ConnectionState, err error) {
+	if e.StateHandler != nil {
+		e.StateHandler(state, err)
+	}
+}
+
+// write sends a finished message to the collector. Errors are not passed on to the MessageStream, since
+// the message is buffered or dropped instead.
+func (e *TCPExporter) write(b []byte) (int, error) {
+	if e.conn == nil {
+		e.connect(binary.BigEndian.Uint32(b[8:12]))
+	}
+	if e.conn != nil {
+		_, err := e.conn.Write(b)
+		if err == nil {
+			return len(b), nil
+		}
+		e.conn.Close()
+		e.conn = nil
+		e.retryLater()
+		e.notify(Disconnected, err)
+	}
+	if e.Policy == BufferWhileDisconnected && e.bufferedSize+len(b) <= e.bufferSize() {
+		e.buffered = append(e.buffered, append([]byte(nil), b...))
+		e.bufferedSize += len(b)
+	} else {
+		e.dropped++
+		// the sequence number only counts the data records that were sent (RFC7011 section 3.1), and the
+		// records of b were counted before the message was finished
+		e.sequence = binary.BigEndian.Uint32(b[8:12])
+	}
+	return len(b), nil
+}
+
+// bufferSize returns the maximum number of bytes buffered while disconnected.
+func (e *TCPExporter) bufferSize() int {
+	if e.BufferSize <= 0 {
+		return DefaultBufferSize
+	}
+	return e.BufferSize
+}
+
+// retryLater schedules the next connection attempt with exponential backoff.
+func (e *TCPExporter) retryLater() {
+	e.backoff *= 2
+	if e.backoff < e.MinBackoff {
+		e.backoff = e.MinBackoff
+	}
+	if e.backoff > e.MaxBackoff {
+		e.backoff = e.MaxBackoff
+	}
+	e.nextAttempt = time.Now().Add(e.backoff)
+}
+
+// connect tries to connect to the collector, if the backoff time has passed. On success, all templates
+// and buffered messages are sent. sequence is the sequence number of the next message.
+func (e *TCPExporter) connect(sequence uint32) {
+	if time.Now().Before(e.nextAttempt) {
+		return
+	}
+	conn, err := net.DialTimeout("tcp", e.address, e.DialTimeout)
+	if err == nil {
+		if err = e.replay(conn, sequence); err != nil {
+			conn.Close()
+		}
+	}
+	if err != nil {
+		e.retryLater()
+		e.notify(Disconnected, err)
+		return
+	}
+	e.conn = conn
+	e.backoff = 0
+	e.notify(Connected, nil)
+}
+
+// replay writes all templates and the buffered messages to a new connection.
+func (e *TCPExporter) replay(conn net.Conn, sequence uint32) error {
+	if len(e.buffered) > 0 {
+		sequence = binary.BigEndian.Uint32(e.buffered[0][8:12])
+	}
+	templates, err := MakeMessageStream(conn, e.mtu, e.observationID)
+	if err != nil {
+		return err
+	}
+	templates.templates = e.templates
+	templates.sequence = sequence
+	now := time.Now()
+	if err := templates.sendTemplates(now); err != nil {
+		return err
+	}
+	if err := templates.Flush(now); err != nil {
+		return err
+	}
+	for len(e.buffered) > 0 {
+		if _, err := conn.Write(e.buffered[0]); err != nil {
+			return err
+		}
+		e.bufferedSize -= len(e.buffered[0])
+		e.buffered = e.buffered[1:]
+	}
+	e.buffered = nil
+	return nil
+}
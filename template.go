@@ -6,15 +6,31 @@ import (
 
 type template struct {
 	identifier int16
-	elements   []InformationElement
+	// scope is the number of scope fields of options templates and 0 for normal templates
+	scope    uint16
+	elements []InformationElement
+	// mapping is the last struct mapping that was verified to match this template
+	mapping *structMapping
+	// encoder is the first element of the last RecordEncoder elements verified to match this template
+	encoder *InformationElement
 }
 
 func (t template) id() int16 {
+	if t.scope > 0 {
+		return optionsTemplateSetID
+	}
 	return templateSetID
 }
 
+func (t template) headerSize() int {
+	if t.scope > 0 {
+		return 6
+	}
+	return 4
+}
+
 func (t template) length() (ret int) {
-	ret = 4
+	ret = t.headerSize()
 	for _, element := range t.elements {
 		ret += element.templateSize()
 	}
@@ -22,10 +38,13 @@ func (t template) length() (ret int) {
 }
 
 func (t template) serializeTo(buffer scratchBuffer) error {
-	b, err := buffer.append(4)
+	b, err := buffer.append(t.headerSize())
 	if err != nil {
 		return err
 	}
+	if t.scope > 0 {
+		binary.BigEndian.PutUint16(b[4:], t.scope)
+	}
 	binary.BigEndian.PutUint16(b[2:], uint16(len(t.elements)))
 	binary.BigEndian.PutUint16(b[0:], uint16(t.identifier))
 	for _, element := range t.elements {
@@ -38,18 +57,63 @@ func (t template) serializeTo(buffer scratchBuffer) error {
 }
 
 func (t template) assignDataRecord(record *recordBuffer, values ...interface{}) error {
+	good := record.length()
+	record.template = t.identifier
+	if err := t.serializeRecordTo(record, values); err != nil {
+		record.reset(good)
+		return err
+	}
+	return nil
+}
+
+func (t template) serializeRecordTo(buffer scratchBuffer, values []interface{}) error {
 	if len(values) != len(t.elements) {
 		return TemplateMismatchError{len(values), len(t.elements)}
 	}
-	good := record.length()
-	record.template = t.identifier
 	values = values[:len(t.elements)]
 	for i, element := range t.elements {
-		err := element.serializeDataTo(record, values[i])
-		if err != nil {
-			record.reset(good)
+		if err := element.serializeDataTo(buffer, values[i]); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// matches returns an error if the given information elements can't be used for data records of this
+// template.
+func (t template) matches(elements []InformationElement) error {
+	if len(elements) != len(t.elements) {
+		return TemplateMismatchError{len(elements), len(t.elements)}
+	}
+	for i, element := range t.elements {
+		used := elements[i]
+		if used.Pen != element.Pen || used.ID != element.ID || used.Type != element.Type || used.Length != element.Length {
+			return ElementMismatchError{i, used, element}
+		}
+	}
+	return nil
+}
+
+// templateWithdrawal is a template withdrawal record according to RFC7011 section 8.1
+type templateWithdrawal struct {
+	identifier int16
+	set        int16
+}
+
+func (t templateWithdrawal) id() int16 {
+	return t.set
+}
+
+func (t templateWithdrawal) length() int {
+	return 4
+}
+
+func (t templateWithdrawal) serializeTo(buffer scratchBuffer) error {
+	b, err := buffer.append(4)
+	if err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint16(b[2:], 0)
+	binary.BigEndian.PutUint16(b[0:], uint16(t.identifier))
+	return nil
+}
@@ -0,0 +1,151 @@
+package ipfix
+
+import (
+	"time"
+)
+
+type templateKey struct {
+	session interface{}
+	domain  uint32
+	id      uint16
+}
+
+type cachedTemplate struct {
+	elements []InformationElement
+	// scope is the number of scope fields for options templates and 0 for normal templates
+	scope uint16
+	// received is the time the template was last announced
+	received time.Time
+}
+
+// TemplateCache holds the templates needed for decoding data sets. Templates are scoped by transport
+// session, observation domain, and template id according to RFC7011 section 8. The transport session
+// can be any comparable value, e.g., a connection or the address string of an exporter.
+//
+// TemplateCache is not safe for concurrent use.
+type TemplateCache struct {
+	// Lifetime is the time after which a template expires, if it was not announced again. This is
+	// needed for transport protocols without reliable delivery (RFC7011 section 8.4). 0 disables expiry.
+	Lifetime time.Duration
+	// NetIP makes DecodeDataSet return netip.Addr instead of net.IP for ipv4Address and ipv6Address
+	// elements, which avoids an allocation per address.
+	NetIP bool
+	// Registry is used for looking up the information elements of templates. nil uses the
+	// DefaultRegistry.
+	Registry  *Registry
+	templates map[templateKey]cachedTemplate
+}
+
+// MakeTemplateCache returns a new and empty TemplateCache.
+func MakeTemplateCache() *TemplateCache {
+	return &TemplateCache{
+		templates: make(map[templateKey]cachedTemplate),
+	}
+}
+
+// Update learns the templates and options templates contained in msg, which was received in the given
+// session. Redefinitions replace existing templates; withdrawals remove them.
+func (c *TemplateCache) Update(session interface{}, msg *Message) {
+	domain := msg.ObservationDomainID
+	for _, set := range msg.Sets {
+		switch set := set.(type) {
+		case TemplateSet:
+			for _, rec := range set.Records {
+				c.learn(session, domain, rec.TemplateID, rec.Elements, 0, uint16(templateSetID))
+			}
+		case OptionsTemplateSet:
+			for _, rec := range set.Records {
+				c.learn(session, domain, rec.TemplateID, rec.Elements, rec.ScopeFieldCount, uint16(optionsTemplateSetID))
+			}
+		}
+	}
+}
+
+func (c *TemplateCache) learn(session interface{}, domain uint32, id uint16, elements []InformationElement, scope uint16, set uint16) {
+	if len(elements) == 0 {
+		if id == set {
+			// withdrawal of all templates of this kind
+			for key, t := range c.templates {
+				if key.session == session && key.domain == domain && (t.scope != 0) == (set == uint16(optionsTemplateSetID)) {
+					delete(c.templates, key)
+				}
+			}
+			return
+		}
+		delete(c.templates, templateKey{session, domain, id})
+		return
+	}
+	registry := c.registry()
+	resolved := make([]InformationElement, len(elements))
+	for i, element := range elements {
+		resolved[i] = registry.resolveFieldSpecifier(element)
+	}
+	c.templates[templateKey{session, domain, id}] = cachedTemplate{resolved, scope, time.Now()}
+}
+
+// registry returns the Registry for looking up information elements
+func (c *TemplateCache) registry() *Registry {
+	if c.Registry == nil {
+		return DefaultRegistry
+	}
+	return c.Registry
+}
+
+// resolveFieldSpecifier looks up the information element from the field specifier in r. Unknown
+// information elements are returned as octetArray.
+func (r *Registry) resolveFieldSpecifier(field InformationElement) InformationElement {
+	ie, _ := r.GetByID(field.Pen, field.ID)
+	ie.Length = field.Length
+	return ie
+}
+
+// Lookup returns the information elements of the template with the given id for the given session and
+// observation domain. An UnknownTemplateError is returned if no such template has been learned, e.g.,
+// if the data set arrived before its template, or if the template expired.
+func (c *TemplateCache) Lookup(session interface{}, domain uint32, id uint16) ([]InformationElement, error) {
+	key := templateKey{session, domain, id}
+	t, ok := c.templates[key]
+	if !ok {
+		return nil, UnknownTemplateError(id)
+	}
+	if c.Lifetime > 0 && time.Since(t.received) > c.Lifetime {
+		delete(c.templates, key)
+		return nil, UnknownTemplateError(id)
+	}
+	return t.elements, nil
+}
+
+// DecodeDataSet decodes the data records in the given data set, which was received in the given session
+// and observation domain. An UnknownTemplateError is returned if the template of the data set is unknown.
+func (c *TemplateCache) DecodeDataSet(session interface{}, domain uint32, set DataSet) ([]DataRecord, error) {
+	elements, err := c.Lookup(session, domain, set.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	return decodeRecords(set.TemplateID, elements, set.Data, decoder{
+		lookup: func(id uint16) ([]InformationElement, error) {
+			return c.Lookup(session, domain, id)
+		},
+		netip:    c.NetIP,
+		registry: c.registry(),
+	}, true)
+}
+
+// Forget removes all templates of the given session, e.g., after the transport session was closed.
+func (c *TemplateCache) Forget(session interface{}) {
+	for key := range c.templates {
+		if key.session == session {
+			delete(c.templates, key)
+		}
+	}
+}
+
+// ForgetDomain removes all templates of the given observation domain in the given session, e.g.,
+// after the exporter was restarted.
+func (c *TemplateCache) ForgetDomain(session interface{}, domain uint32) {
+	for key := range c.templates {
+		if key.session == session && key.domain == domain {
+			delete(c.templates, key)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/binary"
 	"math"
 	"net"
+	"net/netip"
+	"reflect"
 	"time"
 )
 
@@ -63,8 +65,14 @@ const (
 	Ipv4AddressType
 	// Ipv6AddressType as defined by RFC7011
 	Ipv6AddressType
-	// BasicListType as defined by RFC7011
+	// BasicListType as defined by RFC6313
 	BasicListType
+	// SubTemplateListType as defined by RFC6313
+	SubTemplateListType
+	// SubTemplateMultiListType as defined by RFC6313
+	SubTemplateMultiListType
+	// Unsigned256Type is a 256 bit unsigned integer as listed in the IANA ipfix data type registry
+	Unsigned256Type
 	// IllegalType is an undefined type
 	IllegalType = -1
 )
@@ -95,6 +103,9 @@ var DefaultSize = [...]uint16{
 	4,
 	16,
 	VariableLength,
+	VariableLength,
+	VariableLength,
+	32,
 }
 
 // NameToType converts the given textual representation of a type to the ipfix type. Returns IllegalType if the type is not recognised.
@@ -140,6 +151,14 @@ func NameToType(x []byte) Type {
 		return Ipv4AddressType
 	case "ipv6Address":
 		return Ipv6AddressType
+	case "basicList":
+		return BasicListType
+	case "subTemplateList":
+		return SubTemplateListType
+	case "subTemplateMultiList":
+		return SubTemplateMultiListType
+	case "unsigned256":
+		return Unsigned256Type
 	}
 	return IllegalType
 }
@@ -188,6 +207,12 @@ func (t Type) String() string {
 		return "ipv6Address"
 	case BasicListType:
 		return "basicList"
+	case SubTemplateListType:
+		return "subTemplateList"
+	case SubTemplateMultiListType:
+		return "subTemplateMultiList"
+	case Unsigned256Type:
+		return "unsigned256"
 	case IllegalType:
 		return "<bad>"
 	}
@@ -207,6 +232,8 @@ func (t Type) serializeDataTo(buffer scratchBuffer, value interface{}, length in
 		return serializeFloatTo(buffer, t, value, length)
 	case DateTimeSecondsType, DateTimeMillisecondsType, DateTimeMicrosecondsType, DateTimeNanosecondsType:
 		return serializeDateTimeTo(buffer, t, value, length)
+	case Unsigned256Type:
+		return serializeUnsigned256To(buffer, value, length)
 	}
 	return 0, IllegalTypeError(t)
 }
@@ -222,6 +249,10 @@ func serializeOctetArrayTo(buffer scratchBuffer, t Type, value interface{}, leng
 		val = []byte(v)
 	case net.HardwareAddr:
 		val = []byte(v)
+	case netip.Addr:
+		return serializeAddrTo(buffer, t, v, length)
+	case netip.Prefix:
+		return serializeAddrTo(buffer, t, v.Masked().Addr(), length)
 	case nil:
 		// val is already nil
 	default:
@@ -230,6 +261,23 @@ func serializeOctetArrayTo(buffer scratchBuffer, t Type, value interface{}, leng
 	if length == 0 {
 		length = int(DefaultSize[t])
 	}
+	if !octetsFit(t, val, length) {
+		return 0, ConversionError{t, value}
+	}
+	return serializeOctetsTo(buffer, val, length)
+}
+
+// octetsFit returns false if val can't be written as an address of the given length.
+func octetsFit(t Type, val []byte, length int) bool {
+	if length == int(VariableLength) || len(val) == length || val == nil {
+		return true
+	}
+	return t != Ipv4AddressType && t != Ipv6AddressType && t != MacAddressType
+}
+
+// serializeOctetsTo writes val with the given length. Shorter values are padded with zeros and longer
+// values are truncated. The caller must ensure the value fits with octetsFit.
+func serializeOctetsTo(buffer scratchBuffer, val []byte, length int) (int, error) {
 	if length == int(VariableLength) {
 		length = len(val)
 		written := length
@@ -272,19 +320,6 @@ func serializeOctetArrayTo(buffer scratchBuffer, t Type, value interface{}, leng
 		copy(b, val)
 		return length, nil
 	}
-	if t == Ipv4AddressType || t == Ipv6AddressType || t == MacAddressType {
-		if val == nil {
-			tmp, err := buffer.append(length)
-			if err != nil {
-				return 0, err
-			}
-			for i := range tmp {
-				tmp[i] = 0
-			}
-			return length, nil
-		}
-		return 0, ConversionError{t, value}
-	}
 	var clear []byte
 	assign, err := buffer.append(length)
 	if err != nil {
@@ -299,22 +334,41 @@ func serializeOctetArrayTo(buffer scratchBuffer, t Type, value interface{}, leng
 }
 
 func serializeIntegerTo(buffer scratchBuffer, t Type, value interface{}, length int) (int, error) {
-	var val uint64
+	val, signed, ok := integerValue(value)
+	if !ok {
+		return 0, ConversionError{t, value}
+	}
+	if buffer.strict() {
+		if err := strictInteger(t, value, length); err != nil {
+			return 0, err
+		}
+	}
+	n, err := serializeUnsignedTo(buffer, t, val, signed, length)
+	if rerr, ok := err.(RangeError); ok {
+		rerr.value = value
+		return 0, rerr
+	}
+	return n, err
+}
+
+// integerValue converts value to an uint64 as used by integer types. Signed values are sign-extended and
+// reported with signed, so they can be told apart from large unsigned values.
+func integerValue(value interface{}) (val uint64, signed bool, ok bool) {
 	switch v := value.(type) {
 	case float64:
-		val = uint64(v)
+		val, signed = uint64(v), v < 0
 	case float32:
-		val = uint64(v)
+		val, signed = uint64(v), v < 0
 	case int64:
-		val = uint64(v)
+		val, signed = uint64(v), true
 	case int32:
-		val = uint64(v)
+		val, signed = uint64(v), true
 	case int16:
-		val = uint64(v)
+		val, signed = uint64(v), true
 	case int8:
-		val = uint64(v)
+		val, signed = uint64(v), true
 	case int:
-		val = uint64(v)
+		val, signed = uint64(v), true
 	case uint64:
 		val = v
 	case uint32:
@@ -325,6 +379,12 @@ func serializeIntegerTo(buffer scratchBuffer, t Type, value interface{}, length
 		val = uint64(v)
 	case uint:
 		val = uint64(v)
+	case netip.Prefix:
+		// the prefix length for address and prefixLength pairs
+		if !v.IsValid() {
+			return 0, false, false
+		}
+		val = uint64(v.Bits())
 	case nil:
 		// val already 0
 	case bool:
@@ -334,11 +394,34 @@ func serializeIntegerTo(buffer scratchBuffer, t Type, value interface{}, length
 			val = 2
 		}
 	default:
-		return 0, ConversionError{t, value}
+		// named integer types like the generated registry values
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			val = rv.Uint()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			val, signed = uint64(rv.Int()), true
+		default:
+			return 0, false, false
+		}
 	}
+	return val, signed, true
+}
+
+// serializeUnsignedTo writes the lowest length bytes of val, which is sign-extended if signed is true. A
+// RangeError is returned if val does not fit into length bytes.
+func serializeUnsignedTo(buffer scratchBuffer, t Type, val uint64, signed bool, length int) (int, error) {
 	if length == 0 {
 		length = int(DefaultSize[t])
 	}
+	if length < 8 && t != BooleanType {
+		if r := integerRange(t, length); !r.holds(val, signed) {
+			if signed {
+				return 0, RangeError{value: int64(val), min: r.Min, max: r.Max}
+			}
+			return 0, RangeError{value: val, min: r.Min, max: r.Max}
+		}
+	}
 	switch length {
 	case 1:
 		b, err := buffer.append(1)
@@ -461,15 +544,22 @@ func serializeFloatTo(buffer scratchBuffer, t Type, value interface{}, length in
 		default:
 			return 0, ConversionError{t, value}
 		}
-		b, err := buffer.append(4)
-		if err != nil {
-			return 0, err
-		}
-		bits := math.Float32bits(val)
-		binary.BigEndian.PutUint32(b, bits)
-		return 4, nil
+		return serializeFloat32To(buffer, val)
+	}
+	val, ok := floatValue(value)
+	if !ok {
+		return 0, ConversionError{t, value}
 	}
-	var val float64
+	n, err := serializeFloat64To(buffer, t, val, length)
+	if rerr, ok := err.(RangeError); ok {
+		rerr.value = value
+		return 0, rerr
+	}
+	return n, err
+}
+
+// floatValue converts value to a float64 as used by float types.
+func floatValue(value interface{}) (val float64, ok bool) {
 	switch v := value.(type) {
 	case float64:
 		val = v
@@ -504,10 +594,29 @@ func serializeFloatTo(buffer scratchBuffer, t Type, value interface{}, length in
 			val = 2
 		}
 	default:
-		return 0, ConversionError{t, value}
+		return 0, false
 	}
+	return val, true
+}
+
+func serializeFloat32To(buffer scratchBuffer, val float32) (int, error) {
+	b, err := buffer.append(4)
+	if err != nil {
+		return 0, err
+	}
+	bits := math.Float32bits(val)
+	binary.BigEndian.PutUint32(b, bits)
+	return 4, nil
+}
+
+// serializeFloat64To writes val as float64 or reduced-size float32 depending on length. A RangeError is
+// returned if val is too big for a reduced-size float32.
+func serializeFloat64To(buffer scratchBuffer, t Type, val float64, length int) (int, error) {
 	switch length {
 	case 4:
+		if math.Abs(val) > math.MaxFloat32 && !math.IsInf(val, 0) {
+			return 0, RangeError{value: val, min: -math.MaxFloat32, max: math.MaxFloat32}
+		}
 		b, err := buffer.append(4)
 		if err != nil {
 			return 0, err
@@ -556,6 +665,11 @@ func serializeDateTimeTo(buffer scratchBuffer, t Type, value interface{}, length
 	default:
 		return 0, ConversionError{t, value}
 	}
+	return serializeTimeTo(buffer, t, seconds, nanoseconds)
+}
+
+// serializeTimeTo writes the given unix time with the precision of t.
+func serializeTimeTo(buffer scratchBuffer, t Type, seconds, nanoseconds uint64) (int, error) {
 	switch t {
 	case DateTimeSecondsType:
 		b, err := buffer.append(4)
@@ -594,5 +708,5 @@ func serializeDateTimeTo(buffer scratchBuffer, t Type, value interface{}, length
 		binary.BigEndian.PutUint32(b[4:8], uint32((nanoseconds<<32)/1e9)+1)
 		return 8, nil
 	}
-	return 0, ConversionError{t, value}
+	return 0, IllegalTypeError(t)
 }
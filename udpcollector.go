@@ -0,0 +1,152 @@
+package ipfix
+
+import (
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultTemplateLifetime is the default lifetime of templates received via UDP. RFC7011 section 8.4
+// recommends three times the template refresh timeout of the exporter.
+const DefaultTemplateLifetime = 3 * DefaultTemplateRefreshInterval
+
+// DefaultTemplateRefreshInterval is the template refresh timeout suggested by RFC7011 section 8.4.
+const DefaultTemplateRefreshInterval = 10 * time.Minute
+
+// maxDatagramSize is the largest possible ipfix message
+const maxDatagramSize = 65535
+
+type udpSessionKey struct {
+	exporter string
+	domain   uint32
+}
+
+type udpSession struct {
+	sequence   uint32
+	exportTime time.Time
+	// received is the time the last message of this session was received
+	received time.Time
+}
+
+// restartSequenceGap is the decrease of the sequence number that is considered an exporter restart,
+// even if the export time did not increase. Smaller decreases within the same export time are
+// reordered datagrams.
+const restartSequenceGap = 1 << 16
+
+// UDPCollector receives ipfix messages via UDP according to RFC7011 section 10.3. Templates are kept
+// per exporter address and observation domain and expire after TemplateLifetime. Exporters that did not
+// send messages for TemplateLifetime are forgotten.
+type UDPCollector struct {
+	// ErrorHandler is called for datagrams and data sets that could not be decoded. Can be nil.
+	ErrorHandler ErrorHandler
+	// TemplateLifetime is the time after which templates expire if they are not refreshed. Must be set
+	// before calling Serve.
+	TemplateLifetime time.Duration
+	// NetIP decodes addresses as netip.Addr instead of net.IP. Must be set before calling Serve.
+	NetIP bool
+	// Registry is used for looking up the information elements of templates. nil uses the
+	// DefaultRegistry. Must be set before calling Serve.
+	Registry *Registry
+	conn     net.PacketConn
+	handler  RecordHandler
+	sessions map[udpSessionKey]udpSession
+	// expired is the time sessions were last checked for expiry
+	expired time.Time
+	closed  int32
+}
+
+// MakeUDPCollector returns a UDPCollector, which receives messages from conn and calls handler for
+// every decoded data record.
+func MakeUDPCollector(conn net.PacketConn, handler RecordHandler) *UDPCollector {
+	return &UDPCollector{
+		TemplateLifetime: DefaultTemplateLifetime,
+		conn:             conn,
+		handler:          handler,
+		sessions:         make(map[udpSessionKey]udpSession),
+	}
+}
+
+// Addr returns the local address of the collector.
+func (c *UDPCollector) Addr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+// Serve receives and dispatches messages until the collector is closed or the connection fails. After
+// Close, nil is returned. The handlers are only called from the goroutine running Serve.
+func (c *UDPCollector) Serve() error {
+	cache := MakeTemplateCache()
+	cache.Lifetime = c.TemplateLifetime
+	cache.NetIP = c.NetIP
+	cache.Registry = c.Registry
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := c.conn.ReadFrom(buf)
+		if err != nil {
+			if atomic.LoadInt32(&c.closed) != 0 {
+				return nil
+			}
+			return err
+		}
+		msg, err := DecodeMessage(buf[:n])
+		if err != nil {
+			if c.ErrorHandler != nil {
+				c.ErrorHandler(addr, err)
+			}
+			continue
+		}
+		session := addr.String()
+		now := time.Now()
+		c.expireSessions(cache, now)
+		c.checkRestart(cache, session, msg, now)
+		dispatchMessage(cache, session, addr, msg, c.handler, c.ErrorHandler)
+	}
+}
+
+// checkRestart drops the templates of an exporter that has been restarted. A restart is assumed if the
+// sequence number went backwards and either the export time increased or the sequence number decreased
+// by at least restartSequenceGap. Export times have a resolution of one second, so other decreases are
+// considered reordered datagrams.
+func (c *UDPCollector) checkRestart(cache *TemplateCache, session string, msg *Message, now time.Time) {
+	key := udpSessionKey{session, msg.ObservationDomainID}
+	last, ok := c.sessions[key]
+	if ok {
+		if back := last.sequence - msg.SequenceNumber; int32(back) > 0 {
+			if !msg.ExportTime.After(last.exportTime) && back < restartSequenceGap {
+				// reordered datagram
+				last.received = now
+				c.sessions[key] = last
+				return
+			}
+			cache.ForgetDomain(session, msg.ObservationDomainID)
+		}
+	}
+	c.sessions[key] = udpSession{msg.SequenceNumber, msg.ExportTime, now}
+}
+
+// expireSessions removes the sessions that did not receive messages for TemplateLifetime together with
+// their templates. If template expiry is disabled, only the session state is removed after
+// DefaultTemplateLifetime.
+func (c *UDPCollector) expireSessions(cache *TemplateCache, now time.Time) {
+	lifetime := c.TemplateLifetime
+	if lifetime <= 0 {
+		lifetime = DefaultTemplateLifetime
+	}
+	if now.Sub(c.expired) < lifetime {
+		return
+	}
+	c.expired = now
+	for key, session := range c.sessions {
+		if now.Sub(session.received) > lifetime {
+			delete(c.sessions, key)
+			if c.TemplateLifetime > 0 {
+				cache.ForgetDomain(key.exporter, key.domain)
+			}
+		}
+	}
+}
+
+// Close stops the collector and closes the underlying connection.
+func (c *UDPCollector) Close() error {
+	atomic.StoreInt32(&c.closed, 1)
+	return c.conn.Close()
+}
@@ -0,0 +1,172 @@
+package ipfix
+
+import (
+	"io"
+	"net"
+	"time"
+)
+
+// unknownPathMTU is the maximum message size that should be used over UDP if the path mtu is unknown
+// according to RFC7011 section 10.3.3
+const unknownPathMTU = 512
+
+// messageCounter counts the messages written to the wrapped writer
+type messageCounter struct {
+	w        io.Writer
+	messages int
+}
+
+func (c *messageCounter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	if err == nil {
+		c.messages++
+	}
+	return n, err
+}
+
+// UDPExporter is a MessageStream that exports via UDP according to RFC7011 section 10.3. All templates
+// are resent periodically, so collectors can recover from packet loss or restarts. The message size is
+// limited to the path mtu of the socket.
+//
+// Template withdrawals must not be sent via UDP (RFC7011 section 8.4); WithdrawTemplate and
+// WithdrawAllTemplates return a WithdrawalError instead.
+type UDPExporter struct {
+	*MessageStream
+	// TemplateRefreshInterval is the time after which all templates are resent, measured in the export
+	// time passed to the send functions. 0 disables the timer.
+	TemplateRefreshInterval time.Duration
+	// TemplateRefreshMessages is the number of messages after which all templates are resent. 0 disables
+	// resending based on the number of messages.
+	TemplateRefreshMessages int
+	counter                 *messageCounter
+	refreshed               time.Time
+	refreshedMessages       int
+}
+
+// MakeUDPExporter returns a UDPExporter, which writes to the connected socket conn. The observationID
+// is used as the observation id in the ipfix messages. Templates are resent every
+// DefaultTemplateRefreshInterval.
+func MakeUDPExporter(conn *net.UDPConn, observationID uint32) (*UDPExporter, error) {
+	counter := &messageCounter{w: conn}
+	stream, err := MakeMessageStream(counter, uint16(udpMessageSize(conn)), observationID)
+	if err != nil {
+		return nil, err
+	}
+	return &UDPExporter{
+		MessageStream:           stream,
+		TemplateRefreshInterval: DefaultTemplateRefreshInterval,
+		counter:                 counter,
+	}, nil
+}
+
+// udpMessageSize returns the maximum message size that fits into a single packet on the path of conn.
+func udpMessageSize(conn *net.UDPConn) int {
+	ipv6 := false
+	var local net.IP
+	if addr, ok := conn.LocalAddr().(*net.UDPAddr); ok {
+		local = addr.IP
+		ipv6 = addr.IP.To4() == nil
+	}
+	mtu, ok := pathMTU(conn, ipv6)
+	if !ok {
+		mtu = interfaceMTU(local)
+	}
+	// subtract ip and udp header
+	overhead := 20 + 8
+	if ipv6 {
+		overhead = 40 + 8
+	}
+	size := mtu - overhead
+	if size < unknownPathMTU {
+		return unknownPathMTU
+	}
+	if size > maxDatagramSize {
+		return maxDatagramSize
+	}
+	return size
+}
+
+// interfaceMTU returns the mtu of the interface with the given address or 0 if it can't be found.
+func interfaceMTU(local net.IP) int {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return 0
+	}
+	for _, iface := range interfaces {
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if ipnet, ok := addr.(*net.IPNet); ok && ipnet.IP.Equal(local) {
+				return iface.MTU
+			}
+		}
+	}
+	return 0
+}
+
+// SendData sends the given values for the given template id like MessageStream.SendData. Before
+// that, all templates are resent if one of the refresh limits was reached.
+func (e *UDPExporter) SendData(now interface{}, template int, data ...interface{}) error {
+	if err := e.refreshTemplates(now); err != nil {
+		return err
+	}
+	return e.MessageStream.SendData(now, template, data...)
+}
+
+// SendStruct sends the given struct or slice of structs like MessageStream.SendStruct. Before that,
+// all templates are resent if one of the refresh limits was reached.
+func (e *UDPExporter) SendStruct(now interface{}, template int, v interface{}) error {
+	if err := e.refreshTemplates(now); err != nil {
+		return err
+	}
+	return e.MessageStream.SendStruct(now, template, v)
+}
+
+// SendRecord sends the given record like MessageStream.SendRecord. Before that, all templates are
+// resent if one of the refresh limits was reached.
+func (e *UDPExporter) SendRecord(now interface{}, template int, record RecordEncoder) error {
+	if err := e.refreshTemplates(now); err != nil {
+		return err
+	}
+	return e.MessageStream.SendRecord(now, template, record)
+}
+
+// Flush finishes and sends the current message like MessageStream.Flush. If one of the refresh limits
+// was reached, all templates are resent before, so exporters that flush periodically refresh the
+// templates even if no data is sent.
+func (e *UDPExporter) Flush(now interface{}) error {
+	if err := e.refreshTemplates(now); err != nil {
+		return err
+	}
+	return e.MessageStream.Flush(now)
+}
+
+// WithdrawTemplate always returns a WithdrawalError, since template withdrawals must not be sent via
+// UDP (RFC7011 section 8.4). Templates time out at the collector instead.
+func (e *UDPExporter) WithdrawTemplate(now interface{}, id int) error {
+	return WithdrawalError("udp")
+}
+
+// WithdrawAllTemplates always returns a WithdrawalError like WithdrawTemplate.
+func (e *UDPExporter) WithdrawAllTemplates(now interface{}) error {
+	return WithdrawalError("udp")
+}
+
+// refreshTemplates resends all templates if the refresh interval or number of messages has been reached.
+// The interval is measured in the export time now.
+func (e *UDPExporter) refreshTemplates(now interface{}) error {
+	current := exportTime(now)
+	if e.refreshed.IsZero() {
+		// templates are sent when they are added; the interval starts with the first send or flush
+		e.refreshed = current
+	}
+	if (e.TemplateRefreshInterval <= 0 || current.Sub(e.refreshed) < e.TemplateRefreshInterval) &&
+		(e.TemplateRefreshMessages <= 0 || e.counter.messages-e.refreshedMessages < e.TemplateRefreshMessages) {
+		return nil
+	}
+	e.refreshed = current
+	e.refreshedMessages = e.counter.messages
+	return e.sendTemplates(now)
+}
@@ -0,0 +1,87 @@
+package ipfix
+
+import "math/big"
+
+// serializeUnsigned256To writes value as big endian unsigned integer with the given length, which can be
+// reduced from 32 bytes. value can be a *big.Int, a [32]byte or []byte holding the big endian number, or
+// any integer value accepted by the other integer types.
+func serializeUnsigned256To(buffer scratchBuffer, value interface{}, length int) (int, error) {
+	t := Unsigned256Type
+	if length == 0 {
+		length = int(DefaultSize[t])
+	}
+	if length < 1 || length > int(DefaultSize[t]) {
+		return 0, SizeError{t, length}
+	}
+	switch v := value.(type) {
+	case *big.Int:
+		if v.Sign() < 0 || v.BitLen() > 8*length {
+			return 0, unsigned256RangeError(value, length)
+		}
+		b, err := buffer.append(length)
+		if err != nil {
+			return 0, err
+		}
+		v.FillBytes(b)
+		return length, nil
+	case [32]byte:
+		return serializeBigEndianTo(buffer, v[:], length, value)
+	case []byte:
+		return serializeBigEndianTo(buffer, v, length, value)
+	}
+	val, _, ok := integerValue(value)
+	if !ok {
+		return 0, ConversionError{t, value}
+	}
+	if buffer.strict() {
+		if err := strictInteger(Unsigned64Type, value, 8); err != nil {
+			return 0, unsigned256RangeError(value, length)
+		}
+	}
+	if length < 8 && val >= 1<<uint(8*length) {
+		return 0, unsigned256RangeError(value, length)
+	}
+	b, err := buffer.append(length)
+	if err != nil {
+		return 0, err
+	}
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] = byte(val)
+		val >>= 8
+	}
+	return length, nil
+}
+
+// serializeBigEndianTo writes the big endian number val right aligned with the given length. Leading
+// zeros are dropped if val is longer than length.
+func serializeBigEndianTo(buffer scratchBuffer, val []byte, length int, value interface{}) (int, error) {
+	for len(val) > length && val[0] == 0 {
+		val = val[1:]
+	}
+	if len(val) > length {
+		return 0, unsigned256RangeError(value, length)
+	}
+	b, err := buffer.append(length)
+	if err != nil {
+		return 0, err
+	}
+	pad := length - len(val)
+	for i := range b[:pad] {
+		b[i] = 0
+	}
+	copy(b[pad:], val)
+	return length, nil
+}
+
+// unsigned256RangeError returns a RangeError for value exceeding an unsigned256 of the given length.
+func unsigned256RangeError(value interface{}, length int) RangeError {
+	max := new(big.Int).Lsh(big.NewInt(1), uint(8*length))
+	return RangeError{value: value, min: int64(0), max: max.Sub(max, big.NewInt(1))}
+}
+
+func decodeUnsigned256(b []byte) (interface{}, error) {
+	if len(b) < 1 || len(b) > int(DefaultSize[Unsigned256Type]) {
+		return nil, SizeError{Unsigned256Type, len(b)}
+	}
+	return new(big.Int).SetBytes(b), nil
+}
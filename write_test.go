@@ -3,7 +3,9 @@ package ipfix_test
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"testing"
 	"time"
 
 	ipfix "github.com/CN-TU/go-ipfix"
@@ -107,8 +109,7 @@ func ExampleMakeMessageStream_basicList() {
 
 	// write out some data
 	// Note that despite octetDeltaCount being defined as uint64, you can use differnt types here
-	// The data will be converted to the right type; There is no automation to export data with shorter
-	// values
+	// The data will be converted to the right type; Shorter values can be chosen with ReducedSize
 	if err := msgStream.SendData(now, id, []uint64{1, 2, 3}); err != nil {
 		fmt.Println("MessageStream.SendData failed:", err)
 	}
@@ -130,6 +131,52 @@ func ExampleMakeMessageStream_basicList() {
 	// Output: 00 0a 00 80 5a 49 7a 03 00 00 00 00 00 00 00 00 00 02 00 0c 01 00 00 01 01 23 ff ff 01 00 00 64 ff 00 1d ff 00 01 00 08 00 00 00 00 00 00 00 01 00 00 00 00 00 00 00 02 00 00 00 00 00 00 00 03 ff 00 15 ff 00 01 00 08 00 00 00 00 00 00 00 04 00 00 00 00 00 00 00 05 ff 00 25 ff 00 01 00 08 00 00 00 00 00 00 00 0a 00 00 00 00 00 00 00 14 00 00 00 00 00 00 00 21 00 00 00 00 00 00 00 64
 }
 
+func ExampleNewBasicListWithSemantic() {
+	// output of this example will be in buf
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC) // simulated fixed time
+
+	// First create a message stream; mtu=0 chooses the default size
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+
+	// Create a basiclist of packet sizes, where the order of the values matters
+	elem, err := ipfix.GetInformationElement("ipTotalLength")
+	if err != nil {
+		fmt.Println("GetInformationElement failed:", err)
+	}
+	ie := ipfix.NewBasicListWithSemantic("packetSizes", elem, 0, ipfix.OrderedSemantic)
+
+	id, err := msgStream.AddTemplate(now, ie)
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+
+	// the semantic of the template is used by default
+	if err := msgStream.SendData(now, id, []uint64{60, 1500}); err != nil {
+		fmt.Println("MessageStream.SendData failed:", err)
+	}
+	// but can be overridden for a single value
+	if err := msgStream.SendData(now, id, ipfix.SemanticList{Semantic: ipfix.AllOfSemantic, Values: []uint64{40}}); err != nil {
+		fmt.Println("MessageStream.SendData failed:", err)
+	}
+	if err := msgStream.Flush(now); err != nil {
+		fmt.Println("MessageStream.Flush failed:", err)
+	}
+
+	// buf holds now the complete ipfix data of this example
+	fmt.Printf("% x", buf.Bytes())
+	// Output: 00 0a 00 48 5a 49 7a 00 00 00 00 00 00 00 00 00 00 02 00 0c 01 00 00 01 01 23 ff ff 01 00 00 2c ff 00 15 04 00 e0 00 08 00 00 00 00 00 00 00 3c 00 00 00 00 00 00 05 dc ff 00 0d 03 00 e0 00 08 00 00 00 00 00 00 00 28
+}
+
 func ExampleMakeMessageStream_basicListVariable() {
 	// output of this example will be in buf
 	buf := new(bytes.Buffer)
@@ -183,3 +230,283 @@ func ExampleMakeMessageStream_basicListVariable() {
 	fmt.Printf("% x", buf.Bytes())
 	// Output: 00 0a 00 72 5a 49 7a 03 00 00 00 00 00 00 00 00 00 02 00 0c 01 00 00 01 01 23 ff ff 01 00 00 56 ff 00 13 ff 00 60 ff ff 05 74 65 73 74 41 01 32 05 74 65 73 74 42 ff 00 16 ff 00 60 ff ff 10 73 6f 6d 65 74 68 69 6e 67 20 6c 6f 6e 67 65 72 ff 00 20 ff 00 60 ff ff 05 73 68 6f 72 74 04 74 65 73 74 04 73 6f 6d 65 04 6d 6f 72 65 05 74 65 73 74 73
 }
+
+func ExampleMessageStream_AddOptionsTemplate() {
+	// output of this example will be in buf
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC) // simulated fixed time
+
+	// First create a message stream; mtu=0 chooses the default size
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+
+	// Add an options template for exporting sampling parameters; samplerId is the only scope field
+	scope, err := ipfix.GetInformationElement("samplerId")
+	if err != nil {
+		fmt.Println("GetInformationElement failed:", err)
+	}
+	interval, err := ipfix.GetInformationElement("samplingInterval")
+	if err != nil {
+		fmt.Println("GetInformationElement failed:", err)
+	}
+	algorithm, err := ipfix.GetInformationElement("samplingAlgorithm")
+	if err != nil {
+		fmt.Println("GetInformationElement failed:", err)
+	}
+	id, err := msgStream.AddOptionsTemplate(now, 1, scope, interval, algorithm)
+	if err != nil {
+		fmt.Println("MessageStream.AddOptionsTemplate failed:", err)
+		return
+	}
+
+	// Data is sent in the same way as for normal templates
+	if err := msgStream.SendData(now, id, 1, 100, 1); err != nil {
+		fmt.Println("MessageStream.SendData failed:", err)
+		return
+	}
+
+	// Call finalize
+	if err := msgStream.Flush(now); err != nil {
+		fmt.Println("MessageStream.Flush failed:", err)
+		return
+	}
+
+	// buf holds now the complete ipfix data of this example
+	fmt.Printf("% x", buf.Bytes())
+	// Output: 00 0a 00 30 5a 49 7a 00 00 00 00 00 00 00 00 00 00 03 00 16 01 00 00 03 00 01 00 30 00 01 00 22 00 04 00 23 00 01 01 00 00 0a 01 00 00 00 64 01
+}
+
+func ExampleMessageStream_WithdrawTemplate() {
+	// output of this example will be in buf
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC) // simulated fixed time
+
+	// First create a message stream; mtu=0 chooses the default size
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+
+	a, err := ipfix.GetInformationElement("octetDeltaCount")
+	if err != nil {
+		fmt.Println("GetInformationElement failed:", err)
+	}
+	b, err := ipfix.GetInformationElement("packetDeltaCount")
+	if err != nil {
+		fmt.Println("GetInformationElement failed:", err)
+	}
+	id, err := msgStream.AddTemplate(now, a)
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+	if err := msgStream.SendData(now, id, 5); err != nil {
+		fmt.Println("MessageStream.SendData failed:", err)
+		return
+	}
+
+	// Withdraw the template; it can't be used for sending data anymore
+	if err := msgStream.WithdrawTemplate(now, id); err != nil {
+		fmt.Println("MessageStream.WithdrawTemplate failed:", err)
+		return
+	}
+	fmt.Println(msgStream.SendData(now, id, 5))
+
+	// The id is reused by the next template
+	newID, err := msgStream.AddTemplate(now, b)
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+	fmt.Println(id == newID)
+
+	// Call finalize
+	if err := msgStream.Flush(now); err != nil {
+		fmt.Println("MessageStream.Flush failed:", err)
+		return
+	}
+
+	// buf holds now the complete ipfix data of this example
+	fmt.Printf("% x", buf.Bytes())
+	// Output: ipfix: Template id 256 unknown
+	// true
+	// 00 0a 00 38 5a 49 7a 00 00 00 00 00 00 00 00 00 00 02 00 0c 01 00 00 01 00 01 00 08 01 00 00 0c 00 00 00 00 00 00 00 05 00 02 00 10 01 00 00 00 01 00 00 01 00 02 00 08
+}
+
+func ExampleMessageStream_NewSubTemplateList() {
+	// buf holds the ipfix stream
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC) // simulated fixed time
+
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+
+	a, _ := ipfix.GetInformationElement("flowStartSeconds")
+	b, _ := ipfix.GetInformationElement("ipTotalLength")
+	c, _ := ipfix.GetInformationElement("flowId")
+
+	// template of the list entries; every entry is the time and size of a packet
+	packet, err := msgStream.AddTemplate(now, a, b)
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+	packets, err := msgStream.NewSubTemplateList("packets", packet)
+	if err != nil {
+		fmt.Println("MessageStream.NewSubTemplateList failed:", err)
+		return
+	}
+	id, err := msgStream.AddTemplate(now, c, packets)
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+	if err := msgStream.SendData(now, id, 1, [][]interface{}{
+		{now, 60},
+		{now.Add(time.Second), 1500},
+	}); err != nil {
+		fmt.Println("MessageStream.SendData failed:", err)
+		return
+	}
+	if err := msgStream.Flush(now); err != nil {
+		fmt.Println("MessageStream.Flush failed:", err)
+		return
+	}
+	fmt.Printf("% x\n", buf.Bytes())
+
+	// decode the message again
+	msg, err := ipfix.DecodeMessage(buf.Bytes())
+	if err != nil {
+		fmt.Println("DecodeMessage failed:", err)
+		return
+	}
+	cache := ipfix.MakeTemplateCache()
+	cache.Update(nil, msg)
+	records, err := cache.DecodeDataSet(nil, 0, msg.Sets[1].(ipfix.DataSet))
+	if err != nil {
+		fmt.Println("TemplateCache.DecodeDataSet failed:", err)
+		return
+	}
+	fmt.Println(records[0].Elements[1].Name, records[0].Values[0])
+	for _, record := range records[0].Values[1].([]ipfix.DataRecord) {
+		fmt.Println(record.TemplateID, record.Values[0], record.Values[1])
+	}
+	// Output: 00 0a 00 56 5a 49 7a 00 00 00 00 00 00 00 00 00 00 02 00 1c 01 00 00 02 00 96 00 04 00 e0 00 08 01 01 00 02 00 94 00 08 01 24 ff ff 01 01 00 2a 00 00 00 00 00 00 00 01 ff 00 1b ff 01 00 5a 49 7a 00 00 00 00 00 00 00 00 3c 5a 49 7a 01 00 00 00 00 00 00 05 dc
+	// subTemplateList 1
+	// 256 2018-01-01 00:00:00 +0000 UTC 60
+	// 256 2018-01-01 00:00:01 +0000 UTC 1500
+}
+
+func ExampleReducedSize() {
+	// output of this example will be in buf
+	buf := new(bytes.Buffer)
+
+	// load the iana information elements
+	ipfix.LoadIANASpec()
+
+	now := time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC) // simulated fixed time
+
+	msgStream, err := ipfix.MakeMessageStream(buf, 0, 0)
+	if err != nil {
+		fmt.Println("MakeMessageStream failed:", err)
+		return
+	}
+
+	a, _ := ipfix.GetInformationElement("octetDeltaCount")
+	b, _ := ipfix.GetInformationElement("packetDeltaCount")
+	c, _ := ipfix.GetInformationElement("samplingProbability")
+
+	// choose the lengths from sample data
+	elements, err := ipfix.ReducedSize([]ipfix.InformationElement{a, b, c},
+		[]interface{}{1500, 1, 0.5},
+		[]interface{}{70000, 50, 0.25},
+	)
+	if err != nil {
+		fmt.Println("ReducedSize failed:", err)
+		return
+	}
+	// or from a declared range
+	elements[1], err = elements[1].ReducedSizeFor(ipfix.ValueRange{Max: 1000})
+	if err != nil {
+		fmt.Println("InformationElement.ReducedSizeFor failed:", err)
+		return
+	}
+	for _, element := range elements {
+		fmt.Println(element.Name, element.Length)
+	}
+
+	id, err := msgStream.AddTemplate(now, elements...)
+	if err != nil {
+		fmt.Println("MessageStream.AddTemplate failed:", err)
+		return
+	}
+	if err := msgStream.SendData(now, id, 3000, 2, 0.5); err != nil {
+		fmt.Println("MessageStream.SendData failed:", err)
+	}
+	// values that don't fit the chosen length are not truncated
+	if err := msgStream.SendData(now, id, 1<<24, 2, 0.5); err != nil {
+		fmt.Println("MessageStream.SendData failed:", err)
+	}
+	if err := msgStream.Flush(now); err != nil {
+		fmt.Println("MessageStream.Flush failed:", err)
+	}
+
+	// buf holds now the complete ipfix data of this example
+	fmt.Printf("% x", buf.Bytes())
+	// Output: octetDeltaCount 3
+	// packetDeltaCount 2
+	// samplingProbability 4
+	// MessageStream.SendData failed: ipfix: Value 16777216 out of range [0, 16777215] of octetDeltaCount[3]
+	// 00 0a 00 31 5a 49 7a 00 00 00 00 00 00 00 00 00 00 02 00 14 01 00 00 03 00 01 00 03 00 02 00 02 01 37 00 04 01 00 00 0d 00 0b b8 00 02 3f 00 00 00
+}
+
+func TestRegisteredListElements(t *testing.T) {
+	ipfix.LoadIANASpec()
+	spec, err := ipfix.MakeIEFromSpec([]byte("stl(0/292)<subTemplateList>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var elements []ipfix.InformationElement
+	for _, name := range []string{"basicList", "subTemplateList", "subTemplateMultiList", "mibObjectValueTable", "mibObjectValueRow"} {
+		ie, err := ipfix.GetInformationElement(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		elements = append(elements, ie)
+	}
+	elements = append(elements, spec)
+	for _, ie := range elements {
+		s, err := ipfix.MakeMessageStream(ioutil.Discard, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id, err := s.AddTemplate(0, ie)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.SendData(0, id, nil); err == nil {
+			t.Errorf("%s: expected error for a registered list element", ie.Name)
+		} else if _, ok := err.(ipfix.ConversionError); !ok {
+			t.Errorf("%s: expected ConversionError, got %v", ie.Name, err)
+		}
+	}
+}